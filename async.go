@@ -0,0 +1,367 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"fmt"
+	"sync"
+)
+
+// OverflowPolicy determines what an asynchronous logger does with an entry when its buffer is full.
+type OverflowPolicy int
+
+const (
+	// Block waits for space in the buffer. This is the default.
+	Block OverflowPolicy = iota
+	// DropNewest drops the new entry.
+	DropNewest
+	// DropOldest drops the oldest buffered entry to make room for the new one.
+	DropOldest
+	// DropBelowLevel drops the new entry if it is below the level set with AsyncDropLevel,
+	// and otherwise waits for space in the buffer.
+	DropBelowLevel
+)
+
+// String returns a lower-case name for the policy, as in "drop-oldest".
+func (p OverflowPolicy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropNewest:
+		return "drop-newest"
+	case DropOldest:
+		return "drop-oldest"
+	case DropBelowLevel:
+		return "drop-below-level"
+	}
+	return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+}
+
+// AsyncOption configures a logger created by NewAsyncLogger.
+type AsyncOption func(*asyncQueue)
+
+// AsyncOverflow sets the policy for entries logged while the buffer is full.
+func AsyncOverflow(policy OverflowPolicy) AsyncOption {
+	return func(q *asyncQueue) {
+		q.policy = policy
+	}
+}
+
+// AsyncDropLevel sets the level below which the DropBelowLevel policy drops entries.
+// The default is WarnLevel, so debug and info entries are dropped.
+func AsyncDropLevel(level Level) AsyncOption {
+	return func(q *asyncQueue) {
+		q.dropLevel = level
+	}
+}
+
+// AsyncStats reports each dropped entry by incrementing a counter with the given name,
+// tagged with the entry's level.
+func AsyncStats(reporter StatsReporter, name string) AsyncOption {
+	return func(q *asyncQueue) {
+		q.reporter = reporter
+		q.statName = name
+	}
+}
+
+// AsyncLogger is a Logger that writes entries from a background goroutine.
+type AsyncLogger interface {
+	Logger
+
+	// Flush waits until every entry logged before the call has been written, then syncs the
+	// underlying logger if it implements Syncer.
+	Flush() error
+
+	// Close flushes the logger and stops its background goroutine. Entries logged after
+	// Close are written synchronously.
+	Close() error
+}
+
+// NewAsyncLogger wraps a logger so that Debug through Error entries are queued in a ring buffer
+// of the given size and written by a background goroutine, keeping slow writes off the caller's
+// path. Messages are formatted before they are queued, so arguments may be safely modified once
+// the logging call returns. Entries are written in order, but callers are no longer reported
+// by loggers that record them.
+//
+// Fatal and Panic entries flush the buffer, then are written synchronously, so no queued entry
+// is lost when the process exits. Loggers derived with WithField and friends share the buffer.
+// The returned logger also implements LevelEnabler and Syncer; Sync is the same as Flush.
+func NewAsyncLogger(logger Logger, size int, opts ...AsyncOption) AsyncLogger {
+	if size <= 0 {
+		size = 1
+	}
+	q := &asyncQueue{
+		buf:       make([]asyncEntry, size),
+		dropLevel: WarnLevel,
+		done:      make(chan struct{}),
+	}
+	q.nonEmpty.L = &q.mu
+	q.progress.L = &q.mu
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	go q.run()
+	return &asyncLogger{Logger: logger, queue: q}
+}
+
+type asyncEntry struct {
+	logger Logger
+	level  Level
+	msg    string
+}
+
+type asyncQueue struct {
+	mu       sync.Mutex
+	nonEmpty sync.Cond // signalled when entries are queued or the queue is closed
+	progress sync.Cond // broadcast when space is freed or entries are written
+
+	buf     []asyncEntry
+	head, n int
+
+	// queued counts accepted entries, and settled those written or dropped after being accepted
+	queued, settled uint64
+	closed          bool
+	done            chan struct{}
+
+	policy    OverflowPolicy
+	dropLevel Level
+	reporter  StatsReporter
+	statName  string
+}
+
+// enqueue queues an entry, reporting false if the queue is closed and the caller should
+// write the entry itself
+func (q *asyncQueue) enqueue(e asyncEntry) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for !q.closed && q.n == len(q.buf) {
+		switch {
+		case q.policy == DropNewest, q.policy == DropBelowLevel && e.level < q.dropLevel:
+			q.dropped(e.level)
+			return true
+		case q.policy == DropOldest:
+			q.dropped(q.pop().level)
+			q.settled++
+		default:
+			q.progress.Wait()
+		}
+	}
+	if q.closed {
+		return false
+	}
+
+	q.buf[(q.head+q.n)%len(q.buf)] = e
+	q.n++
+	q.queued++
+	q.nonEmpty.Signal()
+	return true
+}
+
+// pop removes the oldest entry; the caller must hold the lock and ensure the queue isn't empty
+func (q *asyncQueue) pop() asyncEntry {
+	e := q.buf[q.head]
+	q.buf[q.head] = asyncEntry{}
+	q.head = (q.head + 1) % len(q.buf)
+	q.n--
+	return e
+}
+
+func (q *asyncQueue) dropped(level Level) {
+	if q.reporter != nil {
+		q.reporter.IncCounter(q.statName, Tags{"level": level.String()}, 1)
+	}
+}
+
+func (q *asyncQueue) run() {
+	defer close(q.done)
+
+	batch := make([]asyncEntry, 0, len(q.buf))
+	for {
+		q.mu.Lock()
+		for q.n == 0 && !q.closed {
+			q.nonEmpty.Wait()
+		}
+		if q.n == 0 {
+			q.mu.Unlock()
+			return
+		}
+		for q.n > 0 {
+			batch = append(batch, q.pop())
+		}
+		q.progress.Broadcast()
+		q.mu.Unlock()
+
+		for _, e := range batch {
+			logf(e.logger, e.level, "%s", e.msg)
+		}
+
+		q.mu.Lock()
+		q.settled += uint64(len(batch))
+		q.progress.Broadcast()
+		q.mu.Unlock()
+
+		for i := range batch {
+			batch[i] = asyncEntry{}
+		}
+		batch = batch[:0]
+	}
+}
+
+// flush waits until every entry queued so far has been written or dropped
+func (q *asyncQueue) flush() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for target := q.queued; q.settled < target; {
+		q.progress.Wait()
+	}
+}
+
+func (q *asyncQueue) close() {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	q.closed = true
+	q.nonEmpty.Signal()
+	q.progress.Broadcast()
+	q.mu.Unlock()
+
+	<-q.done
+}
+
+type asyncLogger struct {
+	Logger
+
+	queue *asyncQueue
+}
+
+func (l *asyncLogger) log(level Level, msg string) {
+	if !l.queue.enqueue(asyncEntry{logger: l.Logger, level: level, msg: msg}) {
+		logf(l.Logger, level, "%s", msg)
+	}
+}
+
+func (l *asyncLogger) Debug(args ...interface{}) {
+	if l.Enabled(DebugLevel) {
+		l.log(DebugLevel, fmt.Sprint(args...))
+	}
+}
+
+func (l *asyncLogger) Debugf(format string, args ...interface{}) {
+	if l.Enabled(DebugLevel) {
+		l.log(DebugLevel, fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *asyncLogger) Info(args ...interface{}) {
+	if l.Enabled(InfoLevel) {
+		l.log(InfoLevel, fmt.Sprint(args...))
+	}
+}
+
+func (l *asyncLogger) Infof(format string, args ...interface{}) {
+	if l.Enabled(InfoLevel) {
+		l.log(InfoLevel, fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *asyncLogger) Warn(args ...interface{}) {
+	if l.Enabled(WarnLevel) {
+		l.log(WarnLevel, fmt.Sprint(args...))
+	}
+}
+
+func (l *asyncLogger) Warnf(format string, args ...interface{}) {
+	if l.Enabled(WarnLevel) {
+		l.log(WarnLevel, fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *asyncLogger) Error(args ...interface{}) {
+	if l.Enabled(ErrorLevel) {
+		l.log(ErrorLevel, fmt.Sprint(args...))
+	}
+}
+
+func (l *asyncLogger) Errorf(format string, args ...interface{}) {
+	if l.Enabled(ErrorLevel) {
+		l.log(ErrorLevel, fmt.Sprintf(format, args...))
+	}
+}
+
+func (l *asyncLogger) Fatal(args ...interface{}) {
+	l.queue.flush()
+	l.Logger.Fatal(args...)
+}
+
+func (l *asyncLogger) Fatalf(format string, args ...interface{}) {
+	l.queue.flush()
+	l.Logger.Fatalf(format, args...)
+}
+
+func (l *asyncLogger) Panic(args ...interface{}) {
+	l.queue.flush()
+	l.Logger.Panic(args...)
+}
+
+func (l *asyncLogger) Panicf(format string, args ...interface{}) {
+	l.queue.flush()
+	l.Logger.Panicf(format, args...)
+}
+
+func (l *asyncLogger) WithField(key string, value interface{}) Logger {
+	return &asyncLogger{Logger: l.Logger.WithField(key, value), queue: l.queue}
+}
+
+func (l *asyncLogger) WithFields(keyValues LogFields) Logger {
+	if keyValues == nil {
+		return l
+	}
+	return &asyncLogger{Logger: l.Logger.WithFields(keyValues), queue: l.queue}
+}
+
+func (l *asyncLogger) WithError(err error) Logger {
+	return &asyncLogger{Logger: l.Logger.WithError(err), queue: l.queue}
+}
+
+func (l *asyncLogger) Flush() error {
+	l.queue.flush()
+	return syncLogger(l.Logger)
+}
+
+func (l *asyncLogger) Close() error {
+	l.queue.close()
+	return syncLogger(l.Logger)
+}
+
+func (l *asyncLogger) Sync() error {
+	return l.Flush()
+}
+
+func (l *asyncLogger) Enabled(level Level) bool {
+	return enabled(l.Logger, level)
+}
+
+func (l *asyncLogger) Level() Level {
+	return levelOf(l.Logger)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+	"github.com/uber-common/bark/mocks"
+)
+
+// Blocks every write until the gate is closed, signalling each write it starts
+type gatedLogger struct {
+	bark.Logger
+	started chan string
+	gate    chan struct{}
+}
+
+func newGatedLogger(logger bark.Logger) *gatedLogger {
+	return &gatedLogger{Logger: logger, started: make(chan string, 16), gate: make(chan struct{})}
+}
+
+func (l *gatedLogger) wait(msg string) {
+	l.started <- msg
+	<-l.gate
+}
+
+func (l *gatedLogger) Infof(format string, args ...interface{}) {
+	l.wait(fmt.Sprintf(format, args...))
+	l.Logger.Infof(format, args...)
+}
+
+func (l *gatedLogger) Warnf(format string, args ...interface{}) {
+	l.wait(fmt.Sprintf(format, args...))
+	l.Logger.Warnf(format, args...)
+}
+
+func messages(logs *barktest.ObservedLogs) []string {
+	var msgs []string
+	for _, e := range logs.All() {
+		msgs = append(msgs, e.Message)
+	}
+	return msgs
+}
+
+func TestAsyncLogger(t *testing.T) {
+	observed, logs := barktest.New(bark.DebugLevel)
+	logger := bark.NewAsyncLogger(observed, 4)
+	defer logger.Close()
+
+	var expected []string
+	for i := 0; i < 100; i++ {
+		logger.Infof("entry %d", i)
+		expected = append(expected, fmt.Sprintf("entry %d", i))
+	}
+	require.NoError(t, logger.Flush())
+	assert.Equal(t, expected, messages(logs), "Expected Flush to wait for every entry, in order")
+}
+
+func TestAsyncLoggerEveryLevel(t *testing.T) {
+	observed, logs := barktest.New(bark.InfoLevel)
+	logger := bark.NewAsyncLogger(observed, 16)
+	defer logger.Close()
+
+	args := []interface{}{"mutable"}
+	logger.Debug("disabled")
+	logger.Debugf("disabled %d", 1)
+	logger.Info(args...)
+	logger.Infof("info %s", args...)
+	args[0] = "mutated"
+	logger.Warn("warn")
+	logger.Warnf("warn %d", 1)
+	logger.WithField("foo", "bar").Error("error")
+	logger.WithFields(bark.Fields{"foo": "baz"}).WithError(assert.AnError).Errorf("error %d", 1)
+	require.NoError(t, logger.Flush())
+
+	entries := logs.All()
+	require.Equal(t, 6, len(entries))
+	assert.Equal(t, []string{"mutable", "info mutable", "warn", "warn 1", "error", "error 1"}, messages(logs),
+		"Expected messages to be formatted when logged")
+	assert.Equal(t, bark.WarnLevel, entries[2].Level)
+	assert.Equal(t, bark.Fields{"foo": "bar"}, entries[4].Fields)
+	assert.Equal(t, bark.ErrorLevel, entries[5].Level)
+	assert.Equal(t, assert.AnError, entries[5].Error)
+}
+
+func TestAsyncLoggerOverflow(t *testing.T) {
+	tests := []struct {
+		policy   bark.OverflowPolicy
+		expected []string
+	}{
+		{bark.DropNewest, []string{"first", "a", "b"}},
+		{bark.DropOldest, []string{"first", "c", "d"}},
+		{bark.DropBelowLevel, []string{"first", "a", "b", "d"}},
+		{bark.Block, []string{"first", "a", "b", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			reporter := &mocks.StatsReporter{}
+			reporter.On("IncCounter", mock.Anything, mock.Anything, mock.Anything)
+
+			observed, logs := barktest.New(bark.DebugLevel)
+			gated := newGatedLogger(observed)
+			logger := bark.NewAsyncLogger(gated, 2,
+				bark.AsyncOverflow(tt.policy),
+				bark.AsyncStats(reporter, "logs.dropped"))
+			defer logger.Close()
+
+			logger.Info("first")
+			assert.Equal(t, "first", <-gated.started, "Expected the first entry to be written")
+			logger.Info("a")
+			logger.Info("b")
+
+			// The buffer is now full, so blocking calls must complete in the background.
+			done := make(chan struct{})
+			go func() {
+				logger.Info("c")
+				logger.Warn("d")
+				close(done)
+			}()
+			if tt.policy == bark.Block || tt.policy == bark.DropBelowLevel {
+				select {
+				case <-done:
+					t.Fatal("Expected full buffer to block")
+				case <-time.After(20 * time.Millisecond):
+				}
+				close(gated.gate)
+				<-done
+			} else {
+				<-done
+				close(gated.gate)
+			}
+			require.NoError(t, logger.Flush())
+			assert.Equal(t, tt.expected, messages(logs))
+
+			drops := 5 - len(tt.expected)
+			reporter.AssertNumberOfCalls(t, "IncCounter", drops)
+			if drops > 0 {
+				reporter.AssertCalled(t, "IncCounter", "logs.dropped", bark.Tags{"level": "info"}, int64(1))
+			}
+		})
+	}
+}
+
+func TestAsyncLoggerDropLevel(t *testing.T) {
+	observed, logs := barktest.New(bark.DebugLevel)
+	gated := newGatedLogger(observed)
+	logger := bark.NewAsyncLogger(gated, 1,
+		bark.AsyncOverflow(bark.DropBelowLevel),
+		bark.AsyncDropLevel(bark.ErrorLevel))
+	defer logger.Close()
+
+	logger.Info("first")
+	<-gated.started
+	logger.Info("queued")
+	logger.Warn("dropped")
+	close(gated.gate)
+	require.NoError(t, logger.Flush())
+	assert.Equal(t, []string{"first", "queued"}, messages(logs))
+}
+
+func TestAsyncLoggerPanic(t *testing.T) {
+	observed, logs := barktest.New(bark.DebugLevel)
+	logger := bark.NewAsyncLogger(observed, 16)
+	defer logger.Close()
+
+	logger.Info("queued")
+	assert.Panics(t, func() { logger.Panic("oh no") })
+	logger.Info("queued")
+	assert.Panics(t, func() { logger.Panicf("oh %s", "no") })
+	assert.Equal(t, []string{"queued", "oh no", "queued", "oh no"}, messages(logs),
+		"Expected queued entries to be written before panicking")
+}
+
+func TestAsyncLoggerFatal(t *testing.T) {
+	output := execFatalTool(t, "async.Fatal")
+	lines := bytes.Split(bytes.TrimSpace(output), []byte("\n"))
+	require.Equal(t, 2, len(lines), "Expected queued entries to be written before exiting")
+	assert.Equal(t, "queued", parseLogBytes(lines[0])["msg"])
+	assert.Equal(t, "fatal error", parseLogBytes(lines[1])["msg"])
+}
+
+func TestAsyncLoggerClose(t *testing.T) {
+	out := &syncBuffer{}
+	logger := bark.NewAsyncLogger(newSyncingLogger(out), 16)
+
+	logger.Info("queued")
+	require.NoError(t, logger.Close())
+	assert.Contains(t, out.String(), "queued", "Expected Close to drain the buffer")
+	assert.Equal(t, 1, out.syncs, "Expected Close to sync the underlying logger")
+
+	logger.Info("after close")
+	assert.Contains(t, out.String(), "after close", "Expected entries to be written synchronously after Close")
+	assert.NoError(t, logger.Close(), "Expected Close to be idempotent")
+	assert.NoError(t, logger.(bark.Syncer).Sync())
+}
+
+func TestAsyncLoggerLevelEnabler(t *testing.T) {
+	observed, _ := barktest.New(bark.WarnLevel)
+	logger := bark.NewAsyncLogger(observed, 1)
+	defer logger.Close()
+
+	levels, ok := logger.WithField("foo", "bar").(bark.LevelEnabler)
+	require.True(t, ok, "Expected async logger to implement LevelEnabler")
+	assert.Equal(t, bark.WarnLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.InfoLevel))
+}
+
+func TestOverflowPolicyString(t *testing.T) {
+	assert.Equal(t, "drop-below-level", bark.DropBelowLevel.String())
+	assert.Equal(t, "OverflowPolicy(42)", bark.OverflowPolicy(42).String())
+}
+
+func TestAsyncLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return bark.NewAsyncLogger(logger, 16)
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgokit
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/go-kit/log"
+	"github.com/uber-common/bark"
+)
+
+const (
+	// _messageKey is the key of the message in go-kit entries, by convention.
+	_messageKey = "msg"
+
+	// _errorKey is the key used by WithError, matching logrus.ErrorKey.
+	_errorKey = "error"
+)
+
+// Barkify wraps a go-kit log.Logger so that it satisfies the bark.Logger
+// interface. Since go-kit can't return the values it has been given, the
+// wrapper keeps its own copy of the fields added through WithField,
+// WithFields and WithError to return from the Fields method; values added to
+// the go-kit logger before it was wrapped aren't included. Fields are added
+// in sorted order by WithFields.
+//
+// go-kit loggers don't filter entries by level themselves; use
+// level.NewFilter to do so. Errors returned by the go-kit logger are
+// dropped, since bark.Logger has no way to report them.
+//
+// Fatal and Fatalf write the entry, then exit the process with os.Exit; use
+// bark.WithExitFunc and bark.WithPreExitHook to change what happens after a
+// Fatal entry. If l was created by NewLogger, the original bark.Logger is
+// returned and the options are ignored.
+//
+// The returned logger also implements bark.LevelLogger.
+func Barkify(l log.Logger, opts ...bark.ExitOption) bark.Logger {
+	if k, ok := l.(*kitLogger); ok {
+		return k.l
+	}
+	return &barker{l: l, exit: bark.NewExitFunc(os.Exit, opts...)}
+}
+
+type barker struct {
+	l    log.Logger
+	exit func(code int)
+
+	// fields mirrors the values added through the bark.Logger interface so
+	// that they can be returned from Fields. It's copied on write, never
+	// modified in place.
+	fields bark.Fields
+}
+
+func (b *barker) Debug(args ...interface{}) { b.log(bark.DebugLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Debugf(format string, args ...interface{}) {
+	b.log(bark.DebugLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Info(args ...interface{}) { b.log(bark.InfoLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Infof(format string, args ...interface{}) {
+	b.log(bark.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Warn(args ...interface{}) { b.log(bark.WarnLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Warnf(format string, args ...interface{}) {
+	b.log(bark.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Error(args ...interface{}) { b.log(bark.ErrorLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Errorf(format string, args ...interface{}) {
+	b.log(bark.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Fatal(args ...interface{}) {
+	b.log(bark.FatalLevel, fmt.Sprint(args...))
+	b.exit(1)
+}
+
+func (b *barker) Fatalf(format string, args ...interface{}) {
+	b.log(bark.FatalLevel, fmt.Sprintf(format, args...))
+	b.exit(1)
+}
+
+func (b *barker) Panic(args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	b.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (b *barker) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	b.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (b *barker) Log(level bark.Level, args ...interface{}) {
+	b.log(level, fmt.Sprint(args...))
+}
+
+func (b *barker) Logf(level bark.Level, format string, args ...interface{}) {
+	b.log(level, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) WithField(key string, value interface{}) bark.Logger {
+	return b.with(bark.Fields{key: value}, key, value)
+}
+
+func (b *barker) WithFields(keyValues bark.LogFields) bark.Logger {
+	if keyValues == nil {
+		return b
+	}
+	fields := keyValues.Fields()
+
+	// Deterministic ordering of values.
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	kvs := make([]interface{}, 0, 2*len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, k, fields[k])
+	}
+	return b.with(fields, kvs...)
+}
+
+func (b *barker) WithError(err error) bark.Logger {
+	return b.with(bark.Fields{_errorKey: err}, _errorKey, err)
+}
+
+func (b *barker) Fields() bark.Fields {
+	return b.fields
+}
+
+func (b *barker) with(fields map[string]interface{}, keyvals ...interface{}) *barker {
+	merged := make(bark.Fields, len(b.fields)+len(fields))
+	for k, v := range b.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	clone := *b
+	clone.l = log.With(b.l, keyvals...)
+	clone.fields = merged
+	return &clone
+}
+
+func (b *barker) log(level bark.Level, msg string) {
+	_ = withLevel(b.l, level).Log(_messageKey, msg)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgokit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barkgokit"
+	"github.com/uber-common/bark/barktest"
+)
+
+func newTestLogger(opts ...bark.ExitOption) (bark.Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	return barkgokit.Barkify(log.NewJSONLogger(buf), opts...), buf
+}
+
+func decode(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var m map[string]interface{}
+		require.NoError(t, dec.Decode(&m))
+		entries = append(entries, m)
+	}
+	return entries
+}
+
+func TestBarkifyLevels(t *testing.T) {
+	l, buf := newTestLogger()
+	l.Debug("debug")
+	l.Infof("info %d", 1)
+	l.Warn("warn")
+	l.Errorf("error %d", 1)
+	assert.PanicsWithValue(t, "panic", func() { l.Panic("panic") })
+
+	var got []string
+	for _, e := range decode(t, buf) {
+		got = append(got, e["level"].(string)+": "+e["msg"].(string))
+	}
+	assert.Equal(t, []string{"debug: debug", "info: info 1", "warn: warn", "error: error 1", "error: panic"}, got)
+}
+
+func TestBarkifyFilter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := barkgokit.Barkify(level.NewFilter(log.NewJSONLogger(buf), level.AllowWarn()))
+	l.Info("disabled")
+	l.WithField("foo", "bar").Warn("enabled")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "enabled", entries[0]["msg"])
+	assert.Equal(t, "bar", entries[0]["foo"])
+}
+
+func TestBarkifyFields(t *testing.T) {
+	l, buf := newTestLogger()
+	err := errors.New("oh no")
+
+	child := l.WithFields(bark.Fields{"b": 2, "a": 1}).WithField("c", "x").WithError(err)
+	assert.Equal(t, bark.Fields{"a": 1, "b": 2, "c": "x", "error": err}, child.Fields())
+	assert.Nil(t, l.Fields(), "expected the parent's fields to be unchanged")
+	child.Info("hello")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, map[string]interface{}{
+		"level": "info",
+		"msg":   "hello",
+		"a":     float64(1),
+		"b":     float64(2),
+		"c":     "x",
+		"error": "oh no",
+	}, entries[0])
+}
+
+func TestBarkifyLevelLogger(t *testing.T) {
+	l, buf := newTestLogger()
+	levels, ok := l.(bark.LevelLogger)
+	require.True(t, ok, "expected Barkify to return a LevelLogger")
+
+	levels.Log(bark.WarnLevel, "hello")
+	assert.NotPanics(t, func() { levels.Log(bark.PanicLevel, "oh ", "no") })
+	levels.Logf(bark.FatalLevel, "oh %s", "no")
+
+	var got []string
+	for _, e := range decode(t, buf) {
+		got = append(got, e["level"].(string)+": "+e["msg"].(string))
+	}
+	assert.Equal(t, []string{"warn: hello", "error: oh no", "error: oh no"}, got)
+}
+
+func TestBarkifyFatal(t *testing.T) {
+	var codes []int
+	l, buf := newTestLogger(bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+	l.Fatal("oh ", "no")
+	l.Fatalf("oh %s", "no")
+
+	assert.Equal(t, []int{1, 1}, codes, "expected the exit function to be called after each entry")
+	for _, e := range decode(t, buf) {
+		assert.Equal(t, "error", e["level"])
+		assert.Equal(t, "oh no", e["msg"])
+	}
+}
+
+func TestBarkifyConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		l, _ := newTestLogger()
+		return l
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package barkgokit integrates Bark with go-kit's logger (github.com/go-kit/log).
+//
+// Barkify wraps a go-kit log.Logger so that libraries accepting a
+// bark.Logger can write to it:
+//
+//	logger := barkgokit.Barkify(log.NewLogfmtLogger(os.Stderr))
+//
+// NewLogger does the reverse, so that code using go-kit can log through
+// libraries' existing loggers:
+//
+//	kitLogger := barkgokit.NewLogger(logger)
+//	level.Info(kitLogger).Log("msg", "hello")
+//
+// Levels are recorded with go-kit's level package, which has no panic or
+// fatal levels: bark's panic and fatal entries are written at error level.
+// Messages are recorded under the "msg" key.
+package barkgokit
@@ -0,0 +1,22 @@
+module github.com/uber-common/bark/barkgokit
+
+go 1.20
+
+require (
+	github.com/go-kit/log v0.2.1
+	github.com/stretchr/testify v1.8.4
+	github.com/uber-common/bark v0.0.0-00010101000000-000000000000
+)
+
+require (
+	github.com/cactus/go-statsd-client/statsd v0.0.0-20191106001114-12b4e2b38748 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-logfmt/logfmt v0.5.1 // indirect
+	github.com/konsorten/go-windows-terminal-sequences v1.0.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/sirupsen/logrus v1.4.2 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/uber-common/bark => ../
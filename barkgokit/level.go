@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgokit
+
+import (
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/uber-common/bark"
+)
+
+// withLevel adds the go-kit level equivalent to a bark level to a logger.
+// Since go-kit has no panic or fatal levels, they're logged as errors.
+func withLevel(l log.Logger, lvl bark.Level) log.Logger {
+	switch {
+	case lvl <= bark.DebugLevel:
+		return level.Debug(l)
+	case lvl == bark.InfoLevel:
+		return level.Info(l)
+	case lvl == bark.WarnLevel:
+		return level.Warn(l)
+	}
+	return level.Error(l)
+}
+
+// toBarkLevel converts a go-kit level to the bark level it is logged at.
+func toBarkLevel(v level.Value) bark.Level {
+	switch v {
+	case level.DebugValue():
+		return bark.DebugLevel
+	case level.WarnValue():
+		return bark.WarnLevel
+	case level.ErrorValue():
+		return bark.ErrorLevel
+	}
+	return bark.InfoLevel
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgokit
+
+import (
+	"fmt"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/uber-common/bark"
+)
+
+// NewLogger creates a go-kit log.Logger that writes entries to a
+// bark.Logger. Entries are written at the level added by go-kit's level
+// package, or at info level if there is none, with the "msg" value as the
+// message. Other key-value pairs become fields, and an error under the
+// "error" key is added with WithError. Keys that aren't strings are
+// formatted with fmt.Sprint, and a trailing key without a value gets
+// log.ErrMissingValue, as go-kit's own loggers do.
+//
+// If l was created by Barkify, the original log.Logger is returned.
+func NewLogger(l bark.Logger) log.Logger {
+	if b, ok := l.(*barker); ok {
+		return b.l
+	}
+	return &kitLogger{l: l}
+}
+
+type kitLogger struct {
+	l bark.Logger
+}
+
+func (k *kitLogger) Log(keyvals ...interface{}) error {
+	lvl := bark.InfoLevel
+	var msg string
+	fields := make(bark.Fields, (len(keyvals)+1)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		var v interface{} = log.ErrMissingValue
+		if i+1 < len(keyvals) {
+			v = keyvals[i+1]
+		}
+
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[i])
+		}
+
+		switch lv, isLevel := v.(level.Value); {
+		case isLevel && key == level.Key():
+			lvl = toBarkLevel(lv)
+		case key == _messageKey:
+			msg = fmt.Sprint(v)
+		default:
+			fields[key] = v
+		}
+	}
+
+	logger := k.l
+	if err, ok := fields[_errorKey].(error); ok {
+		delete(fields, _errorKey)
+		logger = logger.WithError(err)
+	}
+	if len(fields) > 0 {
+		logger = logger.WithFields(fields)
+	}
+
+	switch lvl {
+	case bark.DebugLevel:
+		logger.Debug(msg)
+	case bark.WarnLevel:
+		logger.Warn(msg)
+	case bark.ErrorLevel:
+		logger.Error(msg)
+	default:
+		logger.Info(msg)
+	}
+	return nil
+}
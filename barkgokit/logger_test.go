@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgokit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barkgokit"
+	"github.com/uber-common/bark/barktest"
+)
+
+func TestNewLoggerLevels(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	kl := barkgokit.NewLogger(logger)
+	require.NoError(t, level.Debug(kl).Log("msg", "debug"))
+	require.NoError(t, level.Info(kl).Log("msg", "info"))
+	require.NoError(t, level.Warn(kl).Log("msg", "warn"))
+	require.NoError(t, level.Error(kl).Log("msg", "error"))
+	require.NoError(t, kl.Log("msg", "no level"))
+	require.NoError(t, kl.Log("level", "error", "msg", "string level"))
+
+	var got []string
+	for _, e := range logs.AllUntimed() {
+		got = append(got, e.Level.String()+": "+e.Message)
+	}
+	assert.Equal(t, []string{
+		"debug: debug",
+		"info: info",
+		"warn: warn",
+		"error: error",
+		"info: no level",
+		"info: string level",
+	}, got, "unexpected entries")
+	assert.Equal(t, 1, logs.FilterField("level", "error").Len(), "expected values that aren't levels to be kept as fields")
+}
+
+func TestNewLoggerFields(t *testing.T) {
+	err := errors.New("oh no")
+	logger, logs := barktest.New(bark.DebugLevel)
+	kl := log.With(barkgokit.NewLogger(logger), "foo", "bar", "count", log.Valuer(func() interface{} { return 42 }))
+	require.NoError(t, level.Error(kl).Log("msg", "failed", "error", err, 7, "seven", "dangling"))
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "failed", entries[0].Message)
+	assert.Equal(t, err, entries[0].Error)
+	assert.Equal(t, bark.Fields{
+		"foo":      "bar",
+		"count":    42,
+		"error":    err,
+		"7":        "seven",
+		"dangling": log.ErrMissingValue,
+	}, entries[0].Fields)
+}
+
+func TestNewLoggerNoMessage(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	require.NoError(t, barkgokit.NewLogger(logger).Log("foo", "bar"))
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "", entries[0].Message)
+	assert.Equal(t, bark.Fields{"foo": "bar"}, entries[0].Fields)
+}
+
+func TestRoundTrip(t *testing.T) {
+	logger, _ := barktest.New(bark.DebugLevel)
+	assert.Equal(t, logger, barkgokit.Barkify(barkgokit.NewLogger(logger)), "expected Barkify to unwrap loggers created by NewLogger")
+
+	kl := log.NewNopLogger()
+	assert.Equal(t, kl, barkgokit.NewLogger(barkgokit.Barkify(kl)), "expected NewLogger to unwrap loggers created by Barkify")
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package barkgrpc routes gRPC's internal logs (google.golang.org/grpc/grpclog)
+// through a bark.Logger:
+//
+//	grpclog.SetLoggerV2(barkgrpc.New(logger))
+//
+// gRPC's info, warning, error and fatal entries are written at the matching
+// bark levels. gRPC guards its most verbose entries with V; by default only
+// V(0) is enabled, and only if the logger writes info entries. Use Verbosity
+// to enable higher verbosities on loggers that write debug entries.
+//
+// If the logger implements bark.CallerSkipper, entries are attributed to the
+// gRPC code that wrote them rather than to this package.
+package barkgrpc
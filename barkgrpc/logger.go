@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgrpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/uber-common/bark"
+	"google.golang.org/grpc/grpclog"
+)
+
+// _grpclogCallerSkip is the number of stack frames between the code calling
+// grpclog and the bark.Logger: the grpclog function and this package's method.
+const _grpclogCallerSkip = 2
+
+// An Option configures New.
+type Option func(*options)
+
+type options struct {
+	verbosity int
+}
+
+// Verbosity sets the highest verbosity reported as enabled by V. Verbosities
+// above zero are only enabled if the logger also writes debug entries, since
+// gRPC's verbose entries are noisy.
+func Verbosity(v int) Option {
+	return func(o *options) {
+		o.verbosity = v
+	}
+}
+
+// New creates a grpclog.LoggerV2 that writes to a bark.Logger, for use with
+// grpclog.SetLoggerV2. The returned logger also implements
+// grpclog.DepthLoggerV2, which gRPC uses to report the code that wrote each
+// entry.
+//
+// Fatal entries are written with the logger's Fatal method, so the process
+// exits as configured for the logger, for example with bark.WithExitFunc.
+func New(logger bark.Logger, opts ...Option) grpclog.DepthLoggerV2 {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	l := &grpcLogger{l: logger, verbosity: o.verbosity}
+	if skipper, ok := logger.(bark.CallerSkipper); ok {
+		l.skipper = skipper
+		l.l = skipper.AddCallerSkip(_grpclogCallerSkip)
+	}
+	return l
+}
+
+type grpcLogger struct {
+	// l skips the stack frames of grpclog and this package, if the wrapped
+	// logger supports it.
+	l bark.Logger
+
+	// skipper is the wrapped logger, if it implements bark.CallerSkipper.
+	skipper bark.CallerSkipper
+
+	verbosity int
+}
+
+func (g *grpcLogger) Info(args ...interface{}) { g.l.Info(args...) }
+
+func (g *grpcLogger) Infoln(args ...interface{}) { g.l.Info(sprintln(args)) }
+
+func (g *grpcLogger) Infof(format string, args ...interface{}) { g.l.Infof(format, args...) }
+
+func (g *grpcLogger) InfoDepth(depth int, args ...interface{}) {
+	g.depth(depth).Info(sprintln(args))
+}
+
+func (g *grpcLogger) Warning(args ...interface{}) { g.l.Warn(args...) }
+
+func (g *grpcLogger) Warningln(args ...interface{}) { g.l.Warn(sprintln(args)) }
+
+func (g *grpcLogger) Warningf(format string, args ...interface{}) { g.l.Warnf(format, args...) }
+
+func (g *grpcLogger) WarningDepth(depth int, args ...interface{}) {
+	g.depth(depth).Warn(sprintln(args))
+}
+
+func (g *grpcLogger) Error(args ...interface{}) { g.l.Error(args...) }
+
+func (g *grpcLogger) Errorln(args ...interface{}) { g.l.Error(sprintln(args)) }
+
+func (g *grpcLogger) Errorf(format string, args ...interface{}) { g.l.Errorf(format, args...) }
+
+func (g *grpcLogger) ErrorDepth(depth int, args ...interface{}) {
+	g.depth(depth).Error(sprintln(args))
+}
+
+func (g *grpcLogger) Fatal(args ...interface{}) { g.l.Fatal(args...) }
+
+func (g *grpcLogger) Fatalln(args ...interface{}) { g.l.Fatal(sprintln(args)) }
+
+func (g *grpcLogger) Fatalf(format string, args ...interface{}) { g.l.Fatalf(format, args...) }
+
+func (g *grpcLogger) FatalDepth(depth int, args ...interface{}) {
+	g.depth(depth).Fatal(sprintln(args))
+}
+
+// V reports whether entries at the given gRPC verbosity are enabled. V(0) is
+// enabled if the logger writes info entries; higher verbosities are enabled
+// up to the configured Verbosity, if the logger writes debug entries.
+func (g *grpcLogger) V(level int) bool {
+	if level <= 0 {
+		return enabled(g.l, bark.InfoLevel)
+	}
+	return level <= g.verbosity && enabled(g.l, bark.DebugLevel)
+}
+
+// depth returns a logger that skips depth more stack frames than usual, as
+// requested by grpclog's *Depth functions.
+func (g *grpcLogger) depth(depth int) bark.Logger {
+	if g.skipper == nil || depth == 0 {
+		return g.l
+	}
+	return g.skipper.AddCallerSkip(_grpclogCallerSkip + depth)
+}
+
+func enabled(logger bark.Logger, level bark.Level) bool {
+	if levels, ok := logger.(bark.LevelEnabler); ok {
+		return levels.Enabled(level)
+	}
+	return true
+}
+
+// sprintln formats arguments like fmt.Println, without the trailing newline.
+func sprintln(args []interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
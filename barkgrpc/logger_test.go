@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkgrpc_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barkgrpc"
+	"github.com/uber-common/bark/barktest"
+	"google.golang.org/grpc/grpclog"
+)
+
+// plainLogger hides the optional interfaces implemented by the logger it wraps.
+type plainLogger struct{ bark.Logger }
+
+func setLogger(t *testing.T, l grpclog.LoggerV2) {
+	grpclog.SetLoggerV2(l)
+	t.Cleanup(func() { grpclog.SetLoggerV2(grpclog.NewLoggerV2(io.Discard, io.Discard, os.Stderr)) })
+}
+
+func TestLoggerLevels(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	setLogger(t, barkgrpc.New(logger))
+
+	grpclog.Info("info ", 1)
+	grpclog.Infoln("info", 2)
+	grpclog.Infof("info %d", 3)
+	grpclog.Warning("warning ", 1)
+	grpclog.Warningln("warning", 2)
+	grpclog.Warningf("warning %d", 3)
+	grpclog.Error("error ", 1)
+	grpclog.Errorln("error", 2)
+	grpclog.Errorf("error %d", 3)
+
+	var got []string
+	for _, e := range logs.AllUntimed() {
+		got = append(got, e.Level.String()+": "+e.Message)
+		assert.Equal(t, "logger_test.go", filepath.Base(e.Caller.File), "expected entries to be attributed to the grpclog caller")
+	}
+	assert.Equal(t, []string{
+		"info: info 1",
+		"info: info 2",
+		"info: info 3",
+		"warn: warning 1",
+		"warn: warning 2",
+		"warn: warning 3",
+		"error: error 1",
+		"error: error 2",
+		"error: error 3",
+	}, got, "unexpected entries")
+}
+
+func TestLoggerDepth(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	setLogger(t, barkgrpc.New(logger))
+
+	core := grpclog.Component("core")
+	core.Info("info")
+	core.Warningf("warning %d", 1)
+	core.Errorln("error", 1)
+
+	var got []string
+	for _, e := range logs.AllUntimed() {
+		got = append(got, e.Level.String()+": "+e.Message)
+		assert.Equal(t, "logger_test.go", filepath.Base(e.Caller.File), "expected entries to be attributed to the component's caller")
+		assert.Contains(t, e.Caller.Function, "TestLoggerDepth", "unexpected caller function")
+	}
+	assert.Equal(t, []string{
+		"info: [core] info",
+		"warn: [core] warning 1",
+		"error: [core] error 1",
+	}, got, "unexpected entries")
+}
+
+func TestLoggerWithoutCallerSkipper(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	setLogger(t, barkgrpc.New(plainLogger{logger}))
+
+	grpclog.Info("hello")
+	grpclog.Component("core").Info("hello")
+	assert.Equal(t, []string{"hello", "[core] hello"}, messages(logs), "expected entries without caller skipping")
+}
+
+func TestLoggerFatal(t *testing.T) {
+	var codes []int
+	logger, logs := barktest.New(bark.DebugLevel, bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+	l := barkgrpc.New(logger)
+
+	l.Fatal("oh ", "no")
+	l.Fatalln("oh", "no")
+	l.Fatalf("oh %s", "no")
+	l.FatalDepth(0, "oh", "no")
+
+	assert.Equal(t, []int{1, 1, 1, 1}, codes, "expected the logger's exit function to be called after each entry")
+	assert.Equal(t, 4, logs.FilterLevel(bark.FatalLevel).FilterMessage("oh no").Len())
+}
+
+func TestLoggerV(t *testing.T) {
+	tests := []struct {
+		desc  string
+		level bark.Level
+		opts  []barkgrpc.Option
+		plain bool
+		want  []bool // V(0) through V(3)
+	}{
+		{"debug", bark.DebugLevel, nil, false, []bool{true, false, false, false}},
+		{"debug verbose", bark.DebugLevel, []barkgrpc.Option{barkgrpc.Verbosity(2)}, false, []bool{true, true, true, false}},
+		{"info verbose", bark.InfoLevel, []barkgrpc.Option{barkgrpc.Verbosity(2)}, false, []bool{true, false, false, false}},
+		{"warn", bark.WarnLevel, nil, false, []bool{false, false, false, false}},
+		{"no LevelEnabler", bark.WarnLevel, []barkgrpc.Option{barkgrpc.Verbosity(1)}, true, []bool{true, true, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			logger, _ := barktest.New(tt.level)
+			if tt.plain {
+				logger = plainLogger{logger}
+			}
+			l := barkgrpc.New(logger, tt.opts...)
+
+			var got []bool
+			for v := 0; v < len(tt.want); v++ {
+				got = append(got, l.V(v))
+			}
+			assert.Equal(t, tt.want, got, "unexpected verbosities")
+		})
+	}
+}
+
+func messages(logs *barktest.ObservedLogs) []string {
+	var msgs []string
+	for _, e := range logs.All() {
+		msgs = append(msgs, e.Message)
+	}
+	return msgs
+}
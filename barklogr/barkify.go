@@ -0,0 +1,253 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barklogr
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/uber-common/bark"
+)
+
+// _errorKey is the key used for errors added with WithError on info entries,
+// matching logrus.ErrorKey.
+const _errorKey = "error"
+
+// Barkify wraps a logr.Logger so that it satisfies the bark.Logger interface.
+// Debug entries are written at V(1), info and warn entries at V(0), and
+// error, panic and fatal entries as logr errors, with the error added by
+// WithError, if any. Fields become key-value pairs, added in sorted order by
+// WithFields.
+//
+// Fatal and Fatalf write the entry, then exit the process with os.Exit; use
+// bark.WithExitFunc and bark.WithPreExitHook to change what happens after a
+// Fatal entry. If l was created from a sink returned by NewLogSink, the
+// original bark.Logger is returned and the options are ignored.
+//
+// If l's sink implements logr.CallDepthLogSink, entries are attributed to
+// the callers of the returned logger.
+//
+// The returned logger also implements bark.LevelEnabler, bark.LevelLogger,
+// bark.NamedLogger and bark.CallerSkipper.
+func Barkify(l logr.Logger, opts ...bark.ExitOption) bark.Logger {
+	if s, ok := l.GetSink().(*sink); ok && l.GetV() == 0 {
+		return s.l
+	}
+	return &barker{
+		l:      l,
+		caller: l.WithCallDepth(_barkifyCallDepth),
+		depth:  _barkifyCallDepth,
+		exit:   bark.NewExitFunc(os.Exit, opts...),
+	}
+}
+
+// _barkifyCallDepth is the number of stack frames between callers of the
+// bark.Logger methods and logr: each method calls log, which calls logr.
+const _barkifyCallDepth = 2
+
+type barker struct {
+	l    logr.Logger
+	exit func(code int)
+
+	// caller is l with the call depth added, so that entries are attributed
+	// to the barker's callers. l itself is kept for NewLogSink.
+	caller logr.Logger
+	depth  int
+
+	// err is the most recent error added with WithError. It's passed to
+	// logr's Error method rather than added as a value, so that it isn't
+	// logged twice.
+	err error
+
+	// fields mirrors the values added through the bark.Logger interface so
+	// that they can be returned from Fields. It's copied on write, never
+	// modified in place.
+	fields bark.Fields
+}
+
+func (b *barker) Debug(args ...interface{}) { b.log(bark.DebugLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Debugf(format string, args ...interface{}) {
+	b.log(bark.DebugLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Info(args ...interface{}) { b.log(bark.InfoLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Infof(format string, args ...interface{}) {
+	b.log(bark.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Warn(args ...interface{}) { b.log(bark.WarnLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Warnf(format string, args ...interface{}) {
+	b.log(bark.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Error(args ...interface{}) { b.log(bark.ErrorLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Errorf(format string, args ...interface{}) {
+	b.log(bark.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Fatal(args ...interface{}) {
+	b.log(bark.FatalLevel, fmt.Sprint(args...))
+	b.exit(1)
+}
+
+func (b *barker) Fatalf(format string, args ...interface{}) {
+	b.log(bark.FatalLevel, fmt.Sprintf(format, args...))
+	b.exit(1)
+}
+
+func (b *barker) Panic(args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	b.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (b *barker) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	b.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (b *barker) Log(level bark.Level, args ...interface{}) {
+	b.log(level, fmt.Sprint(args...))
+}
+
+func (b *barker) Logf(level bark.Level, format string, args ...interface{}) {
+	b.log(level, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) WithField(key string, value interface{}) bark.Logger {
+	clone := b.with(bark.Fields{key: value})
+	clone.setLogger(b.l.WithValues(key, value))
+	return clone
+}
+
+func (b *barker) WithFields(keyValues bark.LogFields) bark.Logger {
+	if keyValues == nil {
+		return b
+	}
+	fields := keyValues.Fields()
+
+	// Deterministic ordering of values.
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	kvs := make([]interface{}, 0, 2*len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, k, fields[k])
+	}
+
+	clone := b.with(fields)
+	clone.setLogger(b.l.WithValues(kvs...))
+	return clone
+}
+
+func (b *barker) WithError(err error) bark.Logger {
+	clone := b.with(bark.Fields{_errorKey: err})
+	clone.err = err
+	return clone
+}
+
+func (b *barker) Fields() bark.Fields {
+	return b.fields
+}
+
+// Named adds a name to the logr logger with WithName. Since logr doesn't
+// expose names, the name isn't included in Fields.
+func (b *barker) Named(name string) bark.Logger {
+	if name == "" {
+		return b
+	}
+	clone := *b
+	clone.setLogger(b.l.WithName(name))
+	return &clone
+}
+
+func (b *barker) AddCallerSkip(skip int) bark.Logger {
+	clone := *b
+	clone.depth += skip
+	clone.setLogger(b.l)
+	return &clone
+}
+
+func (b *barker) Enabled(level bark.Level) bool {
+	switch {
+	case level <= bark.DebugLevel:
+		return b.l.V(1).Enabled()
+	case level < bark.ErrorLevel:
+		return b.l.Enabled()
+	}
+	return true // logr always writes errors
+}
+
+func (b *barker) Level() bark.Level {
+	for lvl := bark.DebugLevel; lvl < bark.ErrorLevel; lvl++ {
+		if b.Enabled(lvl) {
+			return lvl
+		}
+	}
+	return bark.ErrorLevel
+}
+
+func (b *barker) with(fields bark.Fields) *barker {
+	merged := make(bark.Fields, len(b.fields)+len(fields))
+	for k, v := range b.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	clone := *b
+	clone.fields = merged
+	return &clone
+}
+
+// setLogger sets the logr logger, adding the barker's call depth
+func (b *barker) setLogger(l logr.Logger) {
+	b.l = l
+	b.caller = l.WithCallDepth(b.depth)
+}
+
+// log must be called directly by a bark.Logger method so that the caller is
+// reported correctly.
+func (b *barker) log(level bark.Level, msg string) {
+	if level >= bark.ErrorLevel {
+		b.caller.Error(b.err, msg)
+		return
+	}
+
+	l := b.caller
+	if level <= bark.DebugLevel {
+		l = l.V(1)
+	}
+	if b.err != nil {
+		l.Info(msg, _errorKey, b.err)
+	} else {
+		l.Info(msg)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barklogr_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barklogr"
+	"github.com/uber-common/bark/barktest"
+)
+
+func newTestLogger(verbosity int, opts ...bark.ExitOption) (bark.Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	log := funcr.NewJSON(func(obj string) {
+		buf.WriteString(obj)
+		buf.WriteByte('\n')
+	}, funcr.Options{Verbosity: verbosity})
+	return barklogr.Barkify(log, opts...), buf
+}
+
+func decode(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var m map[string]interface{}
+		require.NoError(t, dec.Decode(&m))
+		entries = append(entries, m)
+	}
+	return entries
+}
+
+func TestBarkifyLevels(t *testing.T) {
+	l, buf := newTestLogger(1)
+	l.Debug("debug")
+	l.Infof("info %d", 1)
+	l.Warn("warn")
+	l.Errorf("error %d", 1)
+	assert.PanicsWithValue(t, "panic", func() { l.Panic("panic") })
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 5)
+	assert.Equal(t, "debug", entries[0]["msg"])
+	assert.Equal(t, float64(1), entries[0]["level"])
+	assert.Equal(t, "info 1", entries[1]["msg"])
+	assert.Equal(t, float64(0), entries[1]["level"])
+	assert.Equal(t, "warn", entries[2]["msg"])
+	assert.Equal(t, float64(0), entries[2]["level"])
+	for _, e := range entries[3:] {
+		assert.Nil(t, e["level"], "expected errors to have no verbosity")
+		assert.Contains(t, e, "error", "expected an error entry")
+	}
+	assert.Equal(t, "error 1", entries[3]["msg"])
+	assert.Equal(t, "panic", entries[4]["msg"])
+}
+
+func TestBarkifyEnabled(t *testing.T) {
+	l, buf := newTestLogger(0)
+	l.Debug("disabled")
+	assert.Equal(t, 0, buf.Len(), "expected debug entries to be dropped at verbosity 0")
+
+	levels, ok := l.(bark.LevelEnabler)
+	require.True(t, ok, "expected Barkify to return a LevelEnabler")
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.DebugLevel))
+	assert.True(t, levels.Enabled(bark.WarnLevel))
+
+	l, _ = newTestLogger(1)
+	assert.Equal(t, bark.DebugLevel, l.(bark.LevelEnabler).Level())
+}
+
+func TestBarkifyFields(t *testing.T) {
+	l, buf := newTestLogger(0)
+	err := errors.New("oh no")
+
+	child := l.WithFields(bark.Fields{"b": 2, "a": 1}).WithField("c", "x").WithError(err)
+	assert.Equal(t, bark.Fields{"a": 1, "b": 2, "c": "x", "error": err}, child.Fields())
+	assert.Nil(t, l.Fields(), "expected the parent's fields to be unchanged")
+
+	child.Info("info")
+	child.Error("error")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.Equal(t, float64(1), e["a"])
+		assert.Equal(t, float64(2), e["b"])
+		assert.Equal(t, "x", e["c"])
+		assert.Equal(t, "oh no", e["error"])
+	}
+}
+
+func TestBarkifyNamed(t *testing.T) {
+	l, buf := newTestLogger(0)
+	named, ok := l.(bark.NamedLogger)
+	require.True(t, ok, "expected Barkify to return a NamedLogger")
+	assert.Equal(t, l, named.Named(""), "expected empty names to be a no-op")
+
+	named.Named("storage").(bark.NamedLogger).Named("cache").Info("hello")
+	entries := decode(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "storage/cache", entries[0]["logger"], "expected logr to join names")
+}
+
+func TestBarkifyLevelLogger(t *testing.T) {
+	l, buf := newTestLogger(0)
+	levels, ok := l.(bark.LevelLogger)
+	require.True(t, ok, "expected Barkify to return a LevelLogger")
+
+	assert.NotPanics(t, func() { levels.Log(bark.PanicLevel, "oh ", "no") })
+	levels.Logf(bark.FatalLevel, "oh %s", "no")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.Equal(t, "oh no", e["msg"])
+	}
+}
+
+func TestBarkifyFatal(t *testing.T) {
+	var codes []int
+	l, buf := newTestLogger(0, bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+	l.Fatal("oh ", "no")
+	l.Fatalf("oh %s", "no")
+
+	assert.Equal(t, []int{1, 1}, codes, "expected the exit function to be called after each entry")
+	entries := decode(t, buf)
+	require.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.Equal(t, "oh no", e["msg"])
+	}
+}
+
+func TestBarkifyRoundTrip(t *testing.T) {
+	log := funcr.New(func(prefix, args string) {}, funcr.Options{})
+	sink := barklogr.NewLogSink(barklogr.Barkify(log))
+	assert.Equal(t, log.GetSink(), sink, "expected NewLogSink to unwrap loggers created by Barkify")
+
+	// A verbosity can't be carried by a bare LogSink, so V loggers aren't unwrapped.
+	sink = barklogr.NewLogSink(barklogr.Barkify(log.V(1)))
+	assert.NotEqual(t, log.GetSink(), sink)
+	assert.NotPanics(t, func() { logr.New(sink).Info("hello") })
+}
+
+func TestBarkifyRoundTripWithError(t *testing.T) {
+	l, buf := newTestLogger(0)
+	err := errors.New("great sadness")
+	log := logr.New(barklogr.NewLogSink(l.WithError(err)))
+	log.Info("info")
+	log.Error(nil, "error")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "great sadness", entries[0]["error"], "expected the error to be kept on info entries")
+	assert.Equal(t, "great sadness", entries[1]["error"], "expected the error to be kept on error entries")
+}
+
+func TestBarkifyConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		l, _ := newTestLogger(1)
+		return l
+	})
+}
+
+func TestBarkifyCaller(t *testing.T) {
+	buf := &bytes.Buffer{}
+	log := funcr.NewJSON(func(obj string) {
+		buf.WriteString(obj)
+		buf.WriteByte('\n')
+	}, funcr.Options{LogCaller: funcr.All})
+	l := barklogr.Barkify(log, bark.WithExitFunc(func(int) {}))
+
+	l.Info("info")
+	l.WithField("foo", "bar").Errorf("error %d", 1)
+	l.(bark.LevelLogger).Log(bark.WarnLevel, "warn")
+	l.Fatal("fatal")
+	func() {
+		l.(bark.CallerSkipper).AddCallerSkip(1).Info("skipped")
+	}()
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 5)
+	for _, e := range entries {
+		caller, ok := e["caller"].(map[string]interface{})
+		require.True(t, ok, "expected a caller in %v", e)
+		assert.Equal(t, "barkify_test.go", caller["file"], "expected %q to be attributed to the caller", e["msg"])
+	}
+}
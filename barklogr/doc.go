@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package barklogr integrates Bark with logr (github.com/go-logr/logr), the
+// logging interface used by Kubernetes client libraries.
+//
+// NewLogSink writes logr entries to any bark.Logger:
+//
+//	log := logr.New(barklogr.NewLogSink(logger))
+//
+// Barkify does the reverse, so that libraries accepting a bark.Logger can
+// write to a logr.Logger:
+//
+//	logger := barklogr.Barkify(log)
+//
+// logr has only two severities, info and error, with info entries qualified
+// by a verbosity. V(0) info entries map to bark's info level and higher
+// verbosities to its debug level; bark's warn entries are written as V(0)
+// info entries.
+package barklogr
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barklogr
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/uber-common/bark"
+)
+
+// _noValue replaces the value of a trailing key without one, matching
+// logr's funcr package.
+const _noValue = "<no-value>"
+
+// NewLogSink creates a logr.LogSink that writes entries to a bark.Logger.
+// V(0) info entries are written at info level and higher verbosities at
+// debug level; error entries are written at error level, with the error
+// added by WithError. Key-value pairs become fields, and names are added
+// with Named if the logger implements bark.NamedLogger, or recorded in the
+// bark.LoggerNameKey field otherwise.
+//
+// The sink implements logr.CallDepthLogSink. If l implements
+// bark.CallerSkipper, entries are attributed to the callers of logr.
+//
+// If l was created by Barkify from a logr.Logger with no verbosity set, and
+// has no error added by WithError, the original logr.LogSink is returned.
+func NewLogSink(l bark.Logger) logr.LogSink {
+	if b, ok := l.(*barker); ok && b.l.GetV() == 0 && b.err == nil {
+		return b.l.GetSink()
+	}
+	return &sink{l: l, caller: l}
+}
+
+type sink struct {
+	l bark.Logger
+
+	// caller is l skipping depth stack frames, so that entries are attributed
+	// to logr's callers. l itself is kept for Barkify.
+	caller bark.Logger
+	depth  int
+
+	// name is the dotted name of loggers that don't implement bark.NamedLogger.
+	name string
+}
+
+// Init skips the frames added by logr, plus the sink's own Info and Error methods.
+func (s *sink) Init(info logr.RuntimeInfo) {
+	s.depth = info.CallDepth + 1
+	s.caller = bark.SkipCaller(s.l, s.depth)
+}
+
+func (s *sink) WithCallDepth(depth int) logr.LogSink {
+	return s.derive(s.l, s.name, s.depth+depth)
+}
+
+func (s *sink) Enabled(level int) bool {
+	if levels, ok := s.l.(bark.LevelEnabler); ok {
+		return levels.Enabled(toBarkLevel(level))
+	}
+	return true
+}
+
+func (s *sink) Info(level int, msg string, keysAndValues ...interface{}) {
+	logger := withValues(s.caller, keysAndValues)
+	if toBarkLevel(level) == bark.DebugLevel {
+		logger.Debug(msg)
+	} else {
+		logger.Info(msg)
+	}
+}
+
+func (s *sink) Error(err error, msg string, keysAndValues ...interface{}) {
+	logger := withValues(s.caller, keysAndValues)
+	if err != nil {
+		logger = logger.WithError(err)
+	}
+	logger.Error(msg)
+}
+
+func (s *sink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return s.derive(withValues(s.l, keysAndValues), s.name, s.depth)
+}
+
+func (s *sink) WithName(name string) logr.LogSink {
+	if named, ok := s.l.(bark.NamedLogger); ok {
+		return s.derive(named.Named(name), "", s.depth)
+	}
+
+	if s.name != "" {
+		name = s.name + "." + name
+	}
+	return s.derive(s.l.WithField(bark.LoggerNameKey, name), name, s.depth)
+}
+
+func (s *sink) derive(l bark.Logger, name string, depth int) *sink {
+	return &sink{l: l, caller: bark.SkipCaller(l, depth), depth: depth, name: name}
+}
+
+func withValues(l bark.Logger, keysAndValues []interface{}) bark.Logger {
+	if len(keysAndValues) == 0 {
+		return l
+	}
+	return l.WithFields(toFields(keysAndValues))
+}
+
+// toBarkLevel converts a logr verbosity to the bark level it is logged at.
+func toBarkLevel(level int) bark.Level {
+	if level > 0 {
+		return bark.DebugLevel
+	}
+	return bark.InfoLevel
+}
+
+// toFields converts logr key-value pairs to bark fields. Keys that aren't
+// strings are formatted with fmt.Sprint.
+func toFields(keysAndValues []interface{}) bark.Fields {
+	fields := make(bark.Fields, (len(keysAndValues)+1)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = fmt.Sprint(keysAndValues[i])
+		}
+		if i+1 < len(keysAndValues) {
+			fields[key] = keysAndValues[i+1]
+		} else {
+			fields[key] = _noValue
+		}
+	}
+	return fields
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barklogr_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barklogr"
+	"github.com/uber-common/bark/barktest"
+)
+
+// plainLogger hides the optional interfaces implemented by the logger it wraps.
+type plainLogger struct{ bark.Logger }
+
+func TestLogSinkLevels(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	log := logr.New(barklogr.NewLogSink(logger))
+	log.Info("info", "foo", "bar")
+	log.V(1).Info("debug")
+	log.V(4).Info("debug")
+	log.Error(nil, "error")
+
+	var got []string
+	for _, e := range logs.AllUntimed() {
+		got = append(got, e.Level.String()+": "+e.Message)
+	}
+	assert.Equal(t, []string{"info: info", "debug: debug", "debug: debug", "error: error"}, got)
+	assert.Equal(t, 1, logs.FilterField("foo", "bar").Len(), "expected key-value pairs to become fields")
+	assert.Nil(t, logs.FilterLevel(bark.ErrorLevel).All()[0].Error, "expected no error for a nil error")
+}
+
+func TestLogSinkEnabled(t *testing.T) {
+	logger, logs := barktest.New(bark.InfoLevel)
+	log := logr.New(barklogr.NewLogSink(logger))
+	assert.True(t, log.Enabled())
+	assert.False(t, log.V(1).Enabled())
+
+	log.V(1).Info("disabled")
+	assert.Equal(t, 0, logs.Len(), "expected V(1) entries to be dropped at info level")
+
+	log = logr.New(barklogr.NewLogSink(plainLogger{logger}))
+	assert.True(t, log.V(1).Enabled(), "expected loggers without LevelEnabler to enable every verbosity")
+}
+
+func TestLogSinkError(t *testing.T) {
+	err := errors.New("oh no")
+	logger, logs := barktest.New(bark.DebugLevel)
+	log := logr.New(barklogr.NewLogSink(logger))
+	log.Error(err, "failed", "attempt", 3)
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 1)
+	assert.Equal(t, bark.ErrorLevel, entries[0].Level)
+	assert.Equal(t, err, entries[0].Error)
+	assert.Equal(t, bark.Fields{"attempt": 3, "error": err}, entries[0].Fields)
+}
+
+func TestLogSinkKeysAndValues(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	log := logr.New(barklogr.NewLogSink(logger)).WithValues("foo", "bar")
+	log.Info("odd", "baz", 1, "dangling")
+	log.Info("non-string", 42, "answer")
+	log.Info("override", "foo", "qux")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 3)
+	assert.Equal(t, bark.Fields{"foo": "bar", "baz": 1, "dangling": "<no-value>"}, entries[0].Fields)
+	assert.Equal(t, bark.Fields{"foo": "bar", "42": "answer"}, entries[1].Fields)
+	assert.Equal(t, bark.Fields{"foo": "qux"}, entries[2].Fields)
+}
+
+func TestLogSinkWithName(t *testing.T) {
+	tests := []struct {
+		desc string
+		wrap func(bark.Logger) bark.Logger
+	}{
+		{"named logger", func(l bark.Logger) bark.Logger { return l }},
+		{"plain logger", func(l bark.Logger) bark.Logger { return plainLogger{l} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			logger, logs := barktest.New(bark.DebugLevel)
+			log := logr.New(barklogr.NewLogSink(tt.wrap(logger)))
+			log.WithName("storage").WithValues("foo", "bar").WithName("cache").Info("hello")
+			assert.Equal(t, 1, logs.FilterField(bark.LoggerNameKey, "storage.cache").FilterField("foo", "bar").Len(),
+				"expected nested names to be joined with dots")
+		})
+	}
+}
+
+func TestLogSinkRoundTrip(t *testing.T) {
+	logger, _ := barktest.New(bark.DebugLevel)
+	assert.Equal(t, logger, barklogr.Barkify(logr.New(barklogr.NewLogSink(logger))),
+		"expected Barkify to unwrap loggers created by NewLogSink")
+}
+
+func TestLogSinkCaller(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	log := logr.New(barklogr.NewLogSink(logger))
+	log.Info("info")
+	log.WithName("storage").WithValues("foo", "bar").V(1).Info("debug")
+	log.Error(nil, "error")
+	func() {
+		log.WithCallDepth(1).Info("skipped")
+	}()
+
+	entries := logs.All()
+	require.Len(t, entries, 4)
+	for _, e := range entries {
+		assert.Equal(t, "sink_test.go", filepath.Base(e.Caller.File), "expected %q to be attributed to the caller", e.Message)
+	}
+	assert.Equal(t, "github.com/uber-common/bark/barklogr_test.TestLogSinkCaller", entries[3].Caller.Function,
+		"expected WithCallDepth to skip the helper's frame")
+}
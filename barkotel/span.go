@@ -0,0 +1,268 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkotel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Names of the fields added to loggers.
+const (
+	TraceIDKey    = "trace_id"
+	SpanIDKey     = "span_id"
+	TraceFlagsKey = "trace_flags"
+)
+
+// Name and attributes of the span events recorded for log entries when
+// RecordErrors is used. The entry's fields are added as attributes too.
+const (
+	EventName   = "log"
+	SeverityKey = attribute.Key("log.severity")
+	MessageKey  = attribute.Key("log.message")
+)
+
+// An Option configures WithSpan.
+type Option func(*options)
+
+type options struct {
+	recordErrors bool
+}
+
+// RecordErrors also records Error, Panic and Fatal entries written to the
+// logger returned by WithSpan as events on the span, with the entry's level,
+// message and fields as attributes.
+func RecordErrors() Option {
+	return func(o *options) {
+		o.recordErrors = true
+	}
+}
+
+// Extractor is a bark.ContextExtractor that adds the trace_id, span_id and
+// trace_flags fields of the span carried by a context, if any.
+func Extractor(ctx context.Context) bark.Fields {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return bark.Fields{
+		TraceIDKey:    sc.TraceID().String(),
+		SpanIDKey:     sc.SpanID().String(),
+		TraceFlagsKey: sc.TraceFlags().String(),
+	}
+}
+
+// WithSpan returns a logger that adds the trace_id, span_id and trace_flags
+// fields of the span carried by ctx to its entries. If ctx carries no valid
+// span, the logger is returned unchanged. Loggers recording events implement
+// bark.CallerSkipper if the given logger does, so that entries are attributed
+// to their callers.
+func WithSpan(ctx context.Context, logger bark.Logger, opts ...Option) bark.Logger {
+	fields := Extractor(ctx)
+	if fields == nil {
+		return logger
+	}
+	logger = logger.WithFields(fields)
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if !o.recordErrors || !span.IsRecording() {
+		return logger
+	}
+	return &spanLogger{Logger: bark.SkipCaller(logger, 1), span: span}
+}
+
+// spanLogger records error entries as events on a span before writing them
+// to the wrapped logger, if the wrapped logger writes their level.
+type spanLogger struct {
+	bark.Logger
+
+	span trace.Span
+}
+
+func (l *spanLogger) Debug(args ...interface{}) {
+	l.Logger.Debug(args...)
+}
+
+func (l *spanLogger) Debugf(format string, args ...interface{}) {
+	l.Logger.Debugf(format, args...)
+}
+
+func (l *spanLogger) Info(args ...interface{}) {
+	l.Logger.Info(args...)
+}
+
+func (l *spanLogger) Infof(format string, args ...interface{}) {
+	l.Logger.Infof(format, args...)
+}
+
+func (l *spanLogger) Warn(args ...interface{}) {
+	l.Logger.Warn(args...)
+}
+
+func (l *spanLogger) Warnf(format string, args ...interface{}) {
+	l.Logger.Warnf(format, args...)
+}
+
+func (l *spanLogger) Error(args ...interface{}) {
+	if l.Enabled(bark.ErrorLevel) {
+		l.record(bark.ErrorLevel, fmt.Sprint(args...))
+	}
+	l.Logger.Error(args...)
+}
+
+func (l *spanLogger) Errorf(format string, args ...interface{}) {
+	if l.Enabled(bark.ErrorLevel) {
+		l.record(bark.ErrorLevel, fmt.Sprintf(format, args...))
+	}
+	l.Logger.Errorf(format, args...)
+}
+
+func (l *spanLogger) Fatal(args ...interface{}) {
+	if l.Enabled(bark.FatalLevel) {
+		l.record(bark.FatalLevel, fmt.Sprint(args...))
+	}
+	l.Logger.Fatal(args...)
+}
+
+func (l *spanLogger) Fatalf(format string, args ...interface{}) {
+	if l.Enabled(bark.FatalLevel) {
+		l.record(bark.FatalLevel, fmt.Sprintf(format, args...))
+	}
+	l.Logger.Fatalf(format, args...)
+}
+
+func (l *spanLogger) Panic(args ...interface{}) {
+	if l.Enabled(bark.PanicLevel) {
+		l.record(bark.PanicLevel, fmt.Sprint(args...))
+	}
+	l.Logger.Panic(args...)
+}
+
+func (l *spanLogger) Panicf(format string, args ...interface{}) {
+	if l.Enabled(bark.PanicLevel) {
+		l.record(bark.PanicLevel, fmt.Sprintf(format, args...))
+	}
+	l.Logger.Panicf(format, args...)
+}
+
+func (l *spanLogger) WithField(key string, value interface{}) bark.Logger {
+	return &spanLogger{Logger: l.Logger.WithField(key, value), span: l.span}
+}
+
+func (l *spanLogger) WithFields(keyValues bark.LogFields) bark.Logger {
+	return &spanLogger{Logger: l.Logger.WithFields(keyValues), span: l.span}
+}
+
+func (l *spanLogger) WithError(err error) bark.Logger {
+	return &spanLogger{Logger: l.Logger.WithError(err), span: l.span}
+}
+
+func (l *spanLogger) AddCallerSkip(skip int) bark.Logger {
+	return &spanLogger{Logger: bark.SkipCaller(l.Logger, skip), span: l.span}
+}
+
+func (l *spanLogger) Enabled(level bark.Level) bool {
+	if levels, ok := l.Logger.(bark.LevelEnabler); ok {
+		return levels.Enabled(level)
+	}
+	return true
+}
+
+func (l *spanLogger) Level() bark.Level {
+	if levels, ok := l.Logger.(bark.LevelEnabler); ok {
+		return levels.Level()
+	}
+	return bark.DebugLevel
+}
+
+func (l *spanLogger) Sync() error {
+	if s, ok := l.Logger.(bark.Syncer); ok {
+		return s.Sync()
+	}
+	return nil
+}
+
+func (l *spanLogger) record(level bark.Level, msg string) {
+	fields := l.Logger.Fields()
+	attrs := make([]attribute.KeyValue, 0, len(fields)+2)
+	attrs = append(attrs, SeverityKey.String(level.String()), MessageKey.String(msg))
+
+	// Deterministic ordering of attributes, leaving out the span's own identifiers.
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		switch k {
+		case TraceIDKey, SpanIDKey, TraceFlagsKey:
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, toAttribute(k, fields[k]))
+	}
+
+	l.span.AddEvent(EventName, trace.WithAttributes(attrs...))
+}
+
+// toAttribute converts a bark field to a span attribute, using the typed
+// attribute constructors for common types and formatting everything else.
+func toAttribute(key string, v interface{}) attribute.KeyValue {
+	k := attribute.Key(key)
+	switch val := v.(type) {
+	case string:
+		return k.String(val)
+	case []string:
+		return k.StringSlice(val)
+	case bool:
+		return k.Bool(val)
+	case []bool:
+		return k.BoolSlice(val)
+	case int:
+		return k.Int(val)
+	case []int:
+		return k.IntSlice(val)
+	case int32:
+		return k.Int64(int64(val))
+	case int64:
+		return k.Int64(val)
+	case []int64:
+		return k.Int64Slice(val)
+	case float32:
+		return k.Float64(float64(val))
+	case float64:
+		return k.Float64(val)
+	case []float64:
+		return k.Float64Slice(val)
+	case error:
+		return k.String(val.Error())
+	case fmt.Stringer:
+		return k.String(val.String())
+	}
+	return k.String(fmt.Sprint(v))
+}
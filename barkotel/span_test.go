@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkotel_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barkotel"
+	"github.com/uber-common/bark/barktest"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTracer() (trace.Tracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return provider.Tracer("barkotel_test"), recorder
+}
+
+func TestWithSpan(t *testing.T) {
+	tracer, _ := newTracer()
+	ctx, span := tracer.Start(context.Background(), "test")
+	defer span.End()
+
+	logger, logs := barktest.New(bark.DebugLevel)
+	barkotel.WithSpan(ctx, logger).Info("hello")
+
+	require.Equal(t, 1, logs.Len())
+	sc := span.SpanContext()
+	assert.Equal(t, bark.Fields{
+		"trace_id":    sc.TraceID().String(),
+		"span_id":     sc.SpanID().String(),
+		"trace_flags": "01",
+	}, logs.All()[0].Fields)
+}
+
+func TestWithSpanNoSpan(t *testing.T) {
+	logger, _ := barktest.New(bark.DebugLevel)
+	assert.Equal(t, logger, barkotel.WithSpan(context.Background(), logger, barkotel.RecordErrors()),
+		"expected logger to be unchanged without a span")
+}
+
+func TestExtractor(t *testing.T) {
+	defer bark.RegisterContextExtractor(barkotel.Extractor)()
+
+	tracer, _ := newTracer()
+	logger, logs := barktest.New(bark.DebugLevel)
+	ctx, span := tracer.Start(bark.NewContext(context.Background(), logger), "test")
+	defer span.End()
+
+	bark.FromContext(ctx).Info("hello")
+	barktest.AssertField(t, logs, barkotel.TraceIDKey, span.SpanContext().TraceID().String())
+	barktest.AssertField(t, logs, barkotel.SpanIDKey, span.SpanContext().SpanID().String())
+
+	assert.Nil(t, barkotel.Extractor(context.Background()), "expected no fields without a span")
+}
+
+func TestRecordErrors(t *testing.T) {
+	tracer, recorder := newTracer()
+	ctx, span := tracer.Start(context.Background(), "test")
+
+	logger, logs := barktest.New(bark.DebugLevel)
+	l := barkotel.WithSpan(ctx, logger, barkotel.RecordErrors())
+	l.Info("not recorded")
+	l.WithField("attempt", 3).WithError(errors.New("great sadness")).Errorf("failed %s", "fetch")
+	assert.Panics(t, func() { l.Panic("oh no") })
+	span.End()
+
+	assert.Equal(t, 3, logs.Len(), "expected every entry to be logged")
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	events := spans[0].Events()
+	require.Len(t, events, 2, "expected error and panic entries to be recorded")
+
+	assert.Equal(t, barkotel.EventName, events[0].Name)
+	assert.Equal(t, []attribute.KeyValue{
+		barkotel.SeverityKey.String("error"),
+		barkotel.MessageKey.String("failed fetch"),
+		attribute.Int("attempt", 3),
+		attribute.String("error", "great sadness"),
+	}, events[0].Attributes)
+
+	assert.Equal(t, []attribute.KeyValue{
+		barkotel.SeverityKey.String("panic"),
+		barkotel.MessageKey.String("oh no"),
+	}, events[1].Attributes)
+}
+
+func TestRecordErrorsForwardsLevels(t *testing.T) {
+	tracer, _ := newTracer()
+	ctx, span := tracer.Start(context.Background(), "test")
+	defer span.End()
+
+	logger, _ := barktest.New(bark.WarnLevel)
+	l := barkotel.WithSpan(ctx, logger, barkotel.RecordErrors()).WithField("foo", "bar")
+
+	levels, ok := l.(bark.LevelEnabler)
+	require.True(t, ok, "expected span logger to implement bark.LevelEnabler")
+	assert.Equal(t, bark.WarnLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.InfoLevel))
+	assert.NoError(t, l.(bark.Syncer).Sync())
+}
+
+func TestRecordErrorsCaller(t *testing.T) {
+	tracer, _ := newTracer()
+	ctx, span := tracer.Start(context.Background(), "test")
+	defer span.End()
+
+	logger, logs := barktest.New(bark.DebugLevel, bark.WithExitFunc(func(int) {}))
+	l := barkotel.WithSpan(ctx, logger, barkotel.RecordErrors()).WithField("foo", "bar")
+	l.Info("hello")
+	l.Errorf("oh %s", "no")
+	l.Fatal("fatal")
+	assert.Panics(t, func() { l.Panic("panic") })
+
+	require.Equal(t, 4, logs.Len())
+	for _, e := range logs.All() {
+		assert.Equal(t, "span_test.go", filepath.Base(e.Caller.File), "expected %v entries to be attributed to the caller", e.Level)
+	}
+}
+
+func TestRecordErrorsDisabledLevels(t *testing.T) {
+	tracer, recorder := newTracer()
+	ctx, span := tracer.Start(context.Background(), "test")
+
+	logger, logs := barktest.New(bark.PanicLevel)
+	l := barkotel.WithSpan(ctx, logger, barkotel.RecordErrors())
+	l.Error("disabled")
+	l.Errorf("disabled %d", 1)
+	assert.Panics(t, func() { l.Panic("oh no") })
+	span.End()
+
+	assert.Equal(t, 1, logs.Len())
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	events := spans[0].Events()
+	require.Len(t, events, 1, "expected entries the logger doesn't write not to be recorded")
+	assert.Contains(t, events[0].Attributes, barkotel.SeverityKey.String("panic"))
+}
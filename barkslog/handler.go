@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkslog
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+
+	"github.com/uber-common/bark"
+)
+
+// NewHandler creates an slog.Handler that writes records to a bark.Logger.
+// Attributes become bark fields, with groups flattened into dotted names, and
+// an error attribute with the key "error" outside any group is added with
+// WithError. Records' times are dropped, since the bark logger records its
+// own. If the logger implements bark.CallerSkipper, it skips the frames
+// between Handle and the code that wrote the record, found by the record's PC,
+// so that entries are attributed to that code rather than to slog.
+//
+// Records at LevelPanic and above are written without panicking or exiting:
+// at their own level if the logger implements bark.LevelLogger, and at error
+// level otherwise. If l was created by Barkify, the original slog.Handler is
+// returned.
+func NewHandler(l bark.Logger) slog.Handler {
+	if s, ok := l.(*slogger); ok {
+		return s.h
+	}
+	return &handler{l: l}
+}
+
+type handler struct {
+	l bark.Logger
+
+	// prefix holds the names of the open groups, each followed by a dot.
+	prefix string
+}
+
+func (h *handler) Enabled(_ context.Context, lvl slog.Level) bool {
+	if levels, ok := h.l.(bark.LevelEnabler); ok {
+		return levels.Enabled(toBarkLevel(lvl))
+	}
+	return true
+}
+
+func (h *handler) Handle(_ context.Context, r slog.Record) error {
+	logger := h.l
+	if skipper, ok := logger.(bark.CallerSkipper); ok && r.PC != 0 {
+		if skip, ok := callerSkip(r.PC); ok {
+			logger = skipper.AddCallerSkip(skip)
+		}
+	}
+	if r.NumAttrs() > 0 {
+		fields := make(bark.Fields, r.NumAttrs())
+		r.Attrs(func(a slog.Attr) bool {
+			addAttr(fields, h.prefix, a)
+			return true
+		})
+		logger = withFields(logger, fields)
+	}
+
+	switch lvl := toBarkLevel(r.Level); lvl {
+	case bark.DebugLevel:
+		logger.Debug(r.Message)
+	case bark.InfoLevel:
+		logger.Info(r.Message)
+	case bark.WarnLevel:
+		logger.Warn(r.Message)
+	case bark.ErrorLevel:
+		logger.Error(r.Message)
+	default:
+		if ll, ok := logger.(bark.LevelLogger); ok {
+			ll.Log(lvl, r.Message)
+		} else {
+			logger.Error(r.Message)
+		}
+	}
+	return nil
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make(bark.Fields, len(attrs))
+	for _, a := range attrs {
+		addAttr(fields, h.prefix, a)
+	}
+	return &handler{l: withFields(h.l, fields), prefix: h.prefix}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &handler{l: h.l, prefix: h.prefix + name + "."}
+}
+
+// withFields adds fields to a logger, adding an error under _errorKey with
+// WithError.
+func withFields(logger bark.Logger, fields bark.Fields) bark.Logger {
+	if err, ok := fields[_errorKey].(error); ok {
+		delete(fields, _errorKey)
+		logger = logger.WithError(err)
+	}
+	if len(fields) > 0 {
+		logger = logger.WithFields(fields)
+	}
+	return logger
+}
+
+// _maxCallerDepth bounds the number of frames searched for a record's caller.
+const _maxCallerDepth = 32
+
+// callerSkip returns the number of stack frames between Handle and the frame
+// with the given PC, which is the caller recorded by slog, and false if that
+// frame isn't on the stack, as when records are handled asynchronously.
+func callerSkip(pc uintptr) (int, bool) {
+	var pcs [_maxCallerDepth]uintptr
+	n := runtime.Callers(3, pcs[:]) // skip Callers, callerSkip and Handle
+	for i, p := range pcs[:n] {
+		if p == pc {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// addAttr adds an attribute to fields, flattening groups into dotted names
+// and dropping empty attributes, as slog.Handler requires.
+func addAttr(fields bark.Fields, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			addAttr(fields, prefix, ga)
+		}
+		return
+	}
+	fields[prefix+a.Key] = a.Value.Any()
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkslog_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barkslog"
+	"github.com/uber-common/bark/barktest"
+)
+
+// Hides the optional interfaces implemented by the wrapped logger
+type plainLogger struct {
+	bark.Logger
+}
+
+func TestHandlerConformance(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	err := slogtest.TestHandler(barkslog.NewHandler(logger), func() []map[string]any {
+		var results []map[string]any
+		for _, e := range logs.All() {
+			m := map[string]any{
+				slog.TimeKey:    e.Time,
+				slog.LevelKey:   e.Level,
+				slog.MessageKey: e.Message,
+			}
+			for k, v := range e.Fields {
+				nest(m, strings.Split(k, "."), v)
+			}
+			results = append(results, m)
+		}
+		return results
+	})
+
+	// Bark loggers record their own times, so records' times are dropped.
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			if !strings.Contains(err.Error(), "zero Record.Time") {
+				t.Error(err)
+			}
+		}
+	} else if err != nil {
+		t.Error(err)
+	}
+}
+
+// nest adds a dotted field to m as nested maps, as slogtest expects groups
+func nest(m map[string]any, keys []string, v any) {
+	for _, k := range keys[:len(keys)-1] {
+		child, ok := m[k].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			m[k] = child
+		}
+		m = child
+	}
+	m[keys[len(keys)-1]] = v
+}
+
+func TestHandlerFields(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	err := errors.New("oh no")
+
+	log := slog.New(barkslog.NewHandler(logger)).With("service", "api").WithGroup("request")
+	log.Info("hello", "id", 42, slog.Group("user", "name", "alice"), slog.Group("empty"))
+	slog.New(barkslog.NewHandler(logger)).Error("failed", "error", err)
+	log.Warn("failed", "error", err)
+
+	entries := logs.All()
+	require.Len(t, entries, 3)
+	assert.Equal(t, bark.Fields{"service": "api", "request.id": int64(42), "request.user.name": "alice"}, entries[0].Fields)
+	assert.Equal(t, err, entries[1].Error, "expected top-level errors to be added with WithError")
+	assert.Nil(t, entries[2].Error, "expected grouped errors to be fields")
+	assert.Equal(t, err, entries[2].Fields["request.error"])
+}
+
+func TestHandlerLevels(t *testing.T) {
+	leveled, leveledLogs := barktest.New(bark.InfoLevel)
+	plain, plainLogs := barktest.New(bark.InfoLevel)
+
+	for _, logger := range []bark.Logger{leveled, plainLogger{plain}} {
+		h := barkslog.NewHandler(logger)
+		log := slog.New(h)
+		log.Debug("debug")
+		log.Info("info")
+		log.Log(context.Background(), slog.LevelInfo+2, "info+2")
+		log.Warn("warn")
+		log.Error("error")
+		assert.NotPanics(t, func() {
+			log.Log(context.Background(), barkslog.LevelPanic, "panic")
+			log.Log(context.Background(), barkslog.LevelFatal, "fatal")
+		}, "expected terminal levels not to panic or exit")
+	}
+
+	assert.False(t, barkslog.NewHandler(leveled).Enabled(context.Background(), slog.LevelDebug))
+	assert.True(t, barkslog.NewHandler(plainLogger{plain}).Enabled(context.Background(), slog.LevelDebug),
+		"expected loggers without LevelEnabler to enable every level")
+
+	var leveledLevels, plainLevels []bark.Level
+	for _, e := range leveledLogs.All() {
+		leveledLevels = append(leveledLevels, e.Level)
+	}
+	for _, e := range plainLogs.All() {
+		plainLevels = append(plainLevels, e.Level)
+	}
+	assert.Equal(t, []bark.Level{
+		bark.InfoLevel, bark.InfoLevel, bark.WarnLevel, bark.ErrorLevel, bark.PanicLevel, bark.FatalLevel,
+	}, leveledLevels)
+	assert.Equal(t, []bark.Level{
+		bark.InfoLevel, bark.InfoLevel, bark.WarnLevel, bark.ErrorLevel, bark.ErrorLevel, bark.ErrorLevel,
+	}, plainLevels, "expected terminal levels at error level without LevelLogger")
+}
+
+func TestHandlerCaller(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	log := slog.New(barkslog.NewHandler(logger))
+	log.Info("info")
+	log.With("foo", "bar").WarnContext(context.Background(), "warn")
+	log.LogAttrs(context.Background(), slog.LevelError, "error", slog.Int("n", 1))
+	slog.New(barkslog.NewHandler(logger)).WithGroup("g").Debug("debug")
+
+	entries := logs.All()
+	require.Len(t, entries, 4)
+	for _, e := range entries {
+		assert.Equal(t, "github.com/uber-common/bark/barkslog_test.TestHandlerCaller", e.Caller.Function,
+			"expected %q to be attributed to the code that wrote the record", e.Message)
+	}
+
+	// Records without a PC are attributed to the handler.
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "no pc", 0)
+	require.NoError(t, barkslog.NewHandler(logger).Handle(context.Background(), r))
+	assert.Equal(t, "handler.go", filepath.Base(logs.All()[4].Caller.File))
+}
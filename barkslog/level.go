@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkslog
+
+import (
+	"log/slog"
+
+	"github.com/uber-common/bark"
+)
+
+const (
+	// LevelPanic is the slog level of entries written by bark's Panic methods.
+	LevelPanic = slog.LevelError + 4
+	// LevelFatal is the slog level of entries written by bark's Fatal methods.
+	LevelFatal = slog.LevelError + 8
+)
+
+// toSlogLevel converts a bark level to the equivalent slog level.
+func toSlogLevel(lvl bark.Level) slog.Level {
+	switch lvl {
+	case bark.DebugLevel:
+		return slog.LevelDebug
+	case bark.InfoLevel:
+		return slog.LevelInfo
+	case bark.WarnLevel:
+		return slog.LevelWarn
+	case bark.ErrorLevel:
+		return slog.LevelError
+	case bark.PanicLevel:
+		return LevelPanic
+	case bark.FatalLevel:
+		return LevelFatal
+	}
+
+	if lvl < bark.DebugLevel {
+		return slog.LevelDebug
+	}
+	return LevelFatal
+}
+
+// toBarkLevel converts an slog level to the bark level it is logged at. Since
+// slog levels are integers, levels between the named ones are rounded down.
+func toBarkLevel(lvl slog.Level) bark.Level {
+	switch {
+	case lvl >= LevelFatal:
+		return bark.FatalLevel
+	case lvl >= LevelPanic:
+		return bark.PanicLevel
+	case lvl >= slog.LevelError:
+		return bark.ErrorLevel
+	case lvl >= slog.LevelWarn:
+		return bark.WarnLevel
+	case lvl >= slog.LevelInfo:
+		return bark.InfoLevel
+	}
+	return bark.DebugLevel
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkslog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+)
+
+// _errorKey is the attribute key used by WithError, matching logrus.ErrorKey.
+const _errorKey = "error"
+
+// Barkify wraps an slog.Handler so that it satisfies the bark.Logger
+// interface. Fields become attributes, added in sorted order by WithFields,
+// and Panic and Fatal entries are written at LevelPanic and LevelFatal.
+//
+// Fatal and Fatalf write the entry, then exit the process with os.Exit; use
+// bark.WithExitFunc and bark.WithPreExitHook to change what happens after a
+// Fatal entry. If h was created by NewHandler outside of any group, the
+// original bark.Logger is returned and the options are ignored.
+//
+// The returned logger also implements bark.LevelEnabler, bark.LevelLogger and
+// bark.CallerSkipper.
+func Barkify(h slog.Handler, opts ...bark.ExitOption) bark.Logger {
+	if bh, ok := h.(*handler); ok && bh.prefix == "" {
+		return bh.l
+	}
+	return &slogger{h: h, exit: bark.NewExitFunc(os.Exit, opts...)}
+}
+
+type slogger struct {
+	h    slog.Handler
+	exit func(code int)
+	skip int
+
+	// fields mirrors the attributes added through the bark.Logger interface
+	// so that they can be returned from Fields. It's copied on write, never
+	// modified in place.
+	fields bark.Fields
+}
+
+func (l *slogger) Debug(args ...interface{}) { l.log(bark.DebugLevel, fmt.Sprint(args...)) }
+
+func (l *slogger) Debugf(format string, args ...interface{}) {
+	l.log(bark.DebugLevel, fmt.Sprintf(format, args...))
+}
+
+func (l *slogger) Info(args ...interface{}) { l.log(bark.InfoLevel, fmt.Sprint(args...)) }
+
+func (l *slogger) Infof(format string, args ...interface{}) {
+	l.log(bark.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+func (l *slogger) Warn(args ...interface{}) { l.log(bark.WarnLevel, fmt.Sprint(args...)) }
+
+func (l *slogger) Warnf(format string, args ...interface{}) {
+	l.log(bark.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+func (l *slogger) Error(args ...interface{}) { l.log(bark.ErrorLevel, fmt.Sprint(args...)) }
+
+func (l *slogger) Errorf(format string, args ...interface{}) {
+	l.log(bark.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+func (l *slogger) Fatal(args ...interface{}) {
+	l.log(bark.FatalLevel, fmt.Sprint(args...))
+	l.exit(1)
+}
+
+func (l *slogger) Fatalf(format string, args ...interface{}) {
+	l.log(bark.FatalLevel, fmt.Sprintf(format, args...))
+	l.exit(1)
+}
+
+func (l *slogger) Panic(args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	l.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (l *slogger) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	l.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (l *slogger) Log(level bark.Level, args ...interface{}) {
+	l.log(level, fmt.Sprint(args...))
+}
+
+func (l *slogger) Logf(level bark.Level, format string, args ...interface{}) {
+	l.log(level, fmt.Sprintf(format, args...))
+}
+
+func (l *slogger) WithField(key string, value interface{}) bark.Logger {
+	return l.with(bark.Fields{key: value}, []slog.Attr{slog.Any(key, value)})
+}
+
+func (l *slogger) WithFields(keyValues bark.LogFields) bark.Logger {
+	if keyValues == nil {
+		return l
+	}
+	fields := keyValues.Fields()
+
+	// Deterministic ordering of attributes.
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.Any(k, fields[k]))
+	}
+	return l.with(fields, attrs)
+}
+
+func (l *slogger) WithError(err error) bark.Logger {
+	return l.with(bark.Fields{_errorKey: err}, []slog.Attr{slog.Any(_errorKey, err)})
+}
+
+func (l *slogger) AddCallerSkip(skip int) bark.Logger {
+	clone := *l
+	clone.skip += skip
+	return &clone
+}
+
+func (l *slogger) Fields() bark.Fields {
+	return l.fields
+}
+
+func (l *slogger) Enabled(level bark.Level) bool {
+	return l.h.Enabled(context.Background(), toSlogLevel(level))
+}
+
+func (l *slogger) Level() bark.Level {
+	for lvl := bark.DebugLevel; lvl < bark.FatalLevel; lvl++ {
+		if l.Enabled(lvl) {
+			return lvl
+		}
+	}
+	return bark.FatalLevel
+}
+
+func (l *slogger) with(fields map[string]interface{}, attrs []slog.Attr) *slogger {
+	merged := make(bark.Fields, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	clone := *l
+	clone.h = l.h.WithAttrs(attrs)
+	clone.fields = merged
+	return &clone
+}
+
+// log must be called directly by a bark.Logger method so that the caller is
+// reported correctly.
+func (l *slogger) log(level bark.Level, msg string) {
+	ctx := context.Background()
+	lvl := toSlogLevel(level)
+	if !l.h.Enabled(ctx, lvl) {
+		return
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(3+l.skip, pcs[:]) // skip Callers, log and the bark.Logger method
+	l.h.Handle(ctx, slog.NewRecord(time.Now(), lvl, msg, pcs[0]))
+}
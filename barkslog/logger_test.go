@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkslog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barkslog"
+	"github.com/uber-common/bark/barktest"
+)
+
+func newTestLogger(level slog.Level, opts ...bark.ExitOption) (bark.Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	h := slog.NewJSONHandler(buf, &slog.HandlerOptions{AddSource: true, Level: level})
+	return barkslog.Barkify(h, opts...), buf
+}
+
+func decode(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var m map[string]interface{}
+		require.NoError(t, dec.Decode(&m))
+		entries = append(entries, m)
+	}
+	return entries
+}
+
+func TestBarkifyLevels(t *testing.T) {
+	l, buf := newTestLogger(slog.LevelInfo)
+	l.Debug("debug")
+	l.Infof("info %d", 1)
+	l.Warn("warn")
+	l.Errorf("error %d", 1)
+	assert.PanicsWithValue(t, "panic", func() { l.Panic("panic") })
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 4)
+	assert.Equal(t, "INFO", entries[0]["level"])
+	assert.Equal(t, "info 1", entries[0]["msg"])
+	assert.Equal(t, "WARN", entries[1]["level"])
+	assert.Equal(t, "ERROR", entries[2]["level"])
+	assert.Equal(t, "ERROR+4", entries[3]["level"])
+
+	levels, ok := l.(bark.LevelEnabler)
+	require.True(t, ok, "expected Barkify to return a LevelEnabler")
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.DebugLevel))
+}
+
+func TestBarkifyFields(t *testing.T) {
+	l, buf := newTestLogger(slog.LevelDebug)
+	err := errors.New("oh no")
+
+	child := l.WithFields(bark.Fields{"b": 2, "a": 1}).WithField("c", "x").WithError(err)
+	assert.Equal(t, bark.Fields{"a": 1, "b": 2, "c": "x", "error": err}, child.Fields())
+	assert.Nil(t, l.Fields(), "expected parent fields to be unchanged")
+	child.Info("hello")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, float64(1), entries[0]["a"])
+	assert.Equal(t, "x", entries[0]["c"])
+	assert.Equal(t, "oh no", entries[0]["error"])
+}
+
+func TestBarkifyCaller(t *testing.T) {
+	l, buf := newTestLogger(slog.LevelDebug)
+	l.Info("hello")
+	l.WithField("foo", "bar").Errorf("hello %s", "world")
+	l.(bark.LevelLogger).Log(bark.WarnLevel, "hello")
+
+	for _, e := range decode(t, buf) {
+		source, ok := e["source"].(map[string]interface{})
+		require.True(t, ok, "expected source to be recorded")
+		assert.Equal(t, "logger_test.go", path.Base(source["file"].(string)), "incorrect caller file")
+	}
+}
+
+func TestBarkifyCallerSkip(t *testing.T) {
+	l, buf := newTestLogger(slog.LevelDebug)
+	skipper, ok := l.(bark.CallerSkipper)
+	require.True(t, ok, "expected Barkify to return a CallerSkipper")
+
+	logVia := func(l bark.Logger) { l.WithField("foo", "bar").Info("hello") }
+	logVia(skipper.AddCallerSkip(1))
+	logVia(l)
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 2)
+	var got []string
+	for _, e := range entries {
+		source, ok := e["source"].(map[string]interface{})
+		require.True(t, ok, "expected source to be recorded")
+		got = append(got, path.Base(source["function"].(string)))
+	}
+	assert.Equal(t, []string{"barkslog_test.TestBarkifyCallerSkip", "barkslog_test.TestBarkifyCallerSkip.func1"}, got,
+		"expected only the skipping logger to skip the helper's frame")
+}
+
+func TestBarkifyFatal(t *testing.T) {
+	var codes []int
+	l, buf := newTestLogger(slog.LevelInfo, bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+	l.Fatal("oh ", "no")
+	l.Fatalf("oh %s", "no")
+	l.(bark.LevelLogger).Logf(bark.FatalLevel, "oh %s", "no")
+
+	assert.Equal(t, []int{1, 1}, codes, "expected Fatal to exit and Logf not to")
+	for _, e := range decode(t, buf) {
+		assert.Equal(t, "ERROR+8", e["level"])
+		assert.Equal(t, "oh no", e["msg"])
+	}
+}
+
+func TestDoubleWrap(t *testing.T) {
+	logger, _ := barktest.New(bark.DebugLevel)
+	assert.Equal(t, logger, barkslog.Barkify(barkslog.NewHandler(logger)), "expected the bark logger to be unwrapped")
+
+	h := slog.NewTextHandler(&bytes.Buffer{}, nil)
+	assert.Equal(t, h, barkslog.NewHandler(barkslog.Barkify(h)), "expected the slog handler to be unwrapped")
+
+	grouped := barkslog.NewHandler(logger).WithGroup("request")
+	assert.NotEqual(t, logger, barkslog.Barkify(grouped), "expected grouped handlers not to be unwrapped")
+}
+
+func TestBarkifyConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		l, _ := newTestLogger(slog.LevelDebug)
+		return l
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barktest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+)
+
+// AssertLogged asserts that at least one entry was observed at the given
+// level with exactly the given message.
+func AssertLogged(t assert.TestingT, logs *ObservedLogs, level bark.Level, msg string, msgAndArgs ...interface{}) bool {
+	if logs.FilterLevel(level).FilterMessage(msg).Len() > 0 {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf("No %v entry with message %q was logged.\n%s", level, msg, describe(logs)), msgAndArgs...)
+}
+
+// AssertNotLogged asserts that no entry was observed at the given level with
+// exactly the given message.
+func AssertNotLogged(t assert.TestingT, logs *ObservedLogs, level bark.Level, msg string, msgAndArgs ...interface{}) bool {
+	if logs.FilterLevel(level).FilterMessage(msg).Len() == 0 {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf("Unexpected %v entry with message %q was logged.\n%s", level, msg, describe(logs)), msgAndArgs...)
+}
+
+// AssertField asserts that at least one entry was observed with the given
+// field set to a deeply equal value.
+func AssertField(t assert.TestingT, logs *ObservedLogs, key string, value interface{}, msgAndArgs ...interface{}) bool {
+	if logs.FilterField(key, value).Len() > 0 {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf("No entry with field %q = %#v was logged.\n%s", key, value, describe(logs)), msgAndArgs...)
+}
+
+// AssertLen asserts that exactly n entries were observed.
+func AssertLen(t assert.TestingT, logs *ObservedLogs, n int, msgAndArgs ...interface{}) bool {
+	if logs.Len() == n {
+		return true
+	}
+	return assert.Fail(t, fmt.Sprintf("Expected %d logged entries, got %d.\n%s", n, logs.Len(), describe(logs)), msgAndArgs...)
+}
+
+// describe lists observed entries to make assertion failures easier to debug.
+func describe(logs *ObservedLogs) string {
+	entries := logs.All()
+	if len(entries) == 0 {
+		return "No entries were logged."
+	}
+
+	var b strings.Builder
+	b.WriteString("Logged entries:")
+	for _, e := range entries {
+		fmt.Fprintf(&b, "\n\t%v %q %v", e.Level, e.Message, e.Fields)
+	}
+	return b.String()
+}
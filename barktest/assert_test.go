@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barktest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+// fakeT records assertion failures instead of failing the test.
+type fakeT struct{ failures []string }
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
+
+func TestAssertions(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger.WithField("user", "alice").Warn("login failed")
+
+	tests := []struct {
+		desc   string
+		assert func(assert.TestingT) bool
+		pass   bool
+	}{
+		{"logged", func(t assert.TestingT) bool {
+			return barktest.AssertLogged(t, logs, bark.WarnLevel, "login failed")
+		}, true},
+		{"logged at other level", func(t assert.TestingT) bool {
+			return barktest.AssertLogged(t, logs, bark.ErrorLevel, "login failed")
+		}, false},
+		{"not logged", func(t assert.TestingT) bool {
+			return barktest.AssertNotLogged(t, logs, bark.InfoLevel, "login failed")
+		}, true},
+		{"not logged but was", func(t assert.TestingT) bool {
+			return barktest.AssertNotLogged(t, logs, bark.WarnLevel, "login failed")
+		}, false},
+		{"field", func(t assert.TestingT) bool {
+			return barktest.AssertField(t, logs, "user", "alice")
+		}, true},
+		{"field mismatch", func(t assert.TestingT) bool {
+			return barktest.AssertField(t, logs, "user", "bob")
+		}, false},
+		{"len", func(t assert.TestingT) bool {
+			return barktest.AssertLen(t, logs, 1)
+		}, true},
+		{"len mismatch", func(t assert.TestingT) bool {
+			return barktest.AssertLen(t, logs, 2)
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ft := &fakeT{}
+			assert.Equal(t, tt.pass, tt.assert(ft), "unexpected assertion result")
+			if tt.pass {
+				assert.Empty(t, ft.failures, "unexpected failures")
+			} else if assert.Len(t, ft.failures, 1, "expected a failure") {
+				assert.Contains(t, ft.failures[0], `warn "login failed" map[user:alice]`,
+					"expected failure to describe logged entries")
+			}
+		})
+	}
+}
+
+func TestAssertionsNoEntries(t *testing.T) {
+	_, logs := barktest.New(bark.DebugLevel)
+	ft := &fakeT{}
+	assert.False(t, barktest.AssertLogged(ft, logs, bark.InfoLevel, "hello"))
+	if assert.Len(t, ft.failures, 1) {
+		assert.Contains(t, ft.failures[0], "No entries were logged.")
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barktest
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+)
+
+// Caller identifies the code that wrote an entry.
+type Caller struct {
+	// Defined is false if the caller couldn't be determined.
+	Defined  bool
+	File     string
+	Line     int
+	Function string
+}
+
+// String returns the caller in file:line form.
+func (c Caller) String() string {
+	if !c.Defined {
+		return "undefined"
+	}
+	return fmt.Sprintf("%s:%d", c.File, c.Line)
+}
+
+// LoggedEntry is an entry written to an observing logger.
+type LoggedEntry struct {
+	Level   bark.Level
+	Time    time.Time
+	Message string
+
+	// Fields holds the fields attached to the logger that wrote the entry, in
+	// the same form that logger's Fields method returns them. An error added
+	// with WithError is included under the "error" key.
+	Fields bark.Fields
+
+	// Error is the most recent error added with WithError, if any.
+	Error error
+
+	Caller Caller
+}
+
+// ObservedLogs is a concurrency-safe, ordered collection of observed entries.
+type ObservedLogs struct {
+	mu   sync.RWMutex
+	logs []LoggedEntry
+}
+
+// Len returns the number of entries observed so far.
+func (o *ObservedLogs) Len() int {
+	o.mu.RLock()
+	n := len(o.logs)
+	o.mu.RUnlock()
+	return n
+}
+
+// All returns a copy of all the observed entries.
+func (o *ObservedLogs) All() []LoggedEntry {
+	o.mu.RLock()
+	ret := make([]LoggedEntry, len(o.logs))
+	copy(ret, o.logs)
+	o.mu.RUnlock()
+	return ret
+}
+
+// TakeAll returns a copy of all the observed entries, and truncates the
+// observed entries.
+func (o *ObservedLogs) TakeAll() []LoggedEntry {
+	o.mu.Lock()
+	ret := o.logs
+	o.logs = nil
+	o.mu.Unlock()
+	return ret
+}
+
+// AllUntimed returns a copy of all the observed entries, but overwrites the
+// timestamp of each entry. This is useful when making assertions in tests.
+func (o *ObservedLogs) AllUntimed() []LoggedEntry {
+	ret := o.All()
+	for i := range ret {
+		ret[i].Time = time.Time{}
+	}
+	return ret
+}
+
+// Filter returns a copy of the observed entries that match the provided
+// function.
+func (o *ObservedLogs) Filter(match func(LoggedEntry) bool) *ObservedLogs {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var filtered []LoggedEntry
+	for _, entry := range o.logs {
+		if match(entry) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return &ObservedLogs{logs: filtered}
+}
+
+// FilterLevel filters entries to those logged at the provided level.
+func (o *ObservedLogs) FilterLevel(level bark.Level) *ObservedLogs {
+	return o.Filter(func(e LoggedEntry) bool {
+		return e.Level == level
+	})
+}
+
+// FilterMessage filters entries to those that have the specified message.
+func (o *ObservedLogs) FilterMessage(msg string) *ObservedLogs {
+	return o.Filter(func(e LoggedEntry) bool {
+		return e.Message == msg
+	})
+}
+
+// FilterMessageSnippet filters entries to those that have a message
+// containing the specified snippet.
+func (o *ObservedLogs) FilterMessageSnippet(snippet string) *ObservedLogs {
+	return o.Filter(func(e LoggedEntry) bool {
+		return strings.Contains(e.Message, snippet)
+	})
+}
+
+// FilterField filters entries to those that have the specified field with a
+// deeply equal value.
+func (o *ObservedLogs) FilterField(key string, value interface{}) *ObservedLogs {
+	return o.Filter(func(e LoggedEntry) bool {
+		v, ok := e.Fields[key]
+		return ok && reflect.DeepEqual(v, value)
+	})
+}
+
+// FilterFieldKey filters entries to those that have the specified field,
+// regardless of its value.
+func (o *ObservedLogs) FilterFieldKey(key string) *ObservedLogs {
+	return o.Filter(func(e LoggedEntry) bool {
+		_, ok := e.Fields[key]
+		return ok
+	})
+}
+
+func (o *ObservedLogs) add(log LoggedEntry) {
+	o.mu.Lock()
+	o.logs = append(o.logs, log)
+	o.mu.Unlock()
+}
+
+// New creates a bark.Logger that records the entries written to it at or
+// above the given level, and the ObservedLogs that holds them.
+//
+// Like any bark.Logger, the returned logger panics after recording Panic
+// entries and exits the process after recording Fatal entries; pass
+// bark.WithExitFunc to record Fatal entries without exiting. It also
+// implements bark.LevelEnabler, bark.LevelLogger, bark.NamedLogger and
+// bark.CallerSkipper.
+func New(level bark.Level, opts ...bark.ExitOption) (bark.Logger, *ObservedLogs) {
+	logs := &ObservedLogs{}
+	return &observer{level: level, logs: logs, exit: bark.NewExitFunc(os.Exit, opts...)}, logs
+}
+
+type observer struct {
+	level bark.Level
+	logs  *ObservedLogs
+	exit  func(code int)
+	skip  int
+
+	// fields and err are copied on write, never modified in place.
+	fields bark.Fields
+	err    error
+}
+
+func (o *observer) Debug(args ...interface{}) { o.log(bark.DebugLevel, fmt.Sprint(args...)) }
+
+func (o *observer) Debugf(format string, args ...interface{}) {
+	o.log(bark.DebugLevel, fmt.Sprintf(format, args...))
+}
+
+func (o *observer) Info(args ...interface{}) { o.log(bark.InfoLevel, fmt.Sprint(args...)) }
+
+func (o *observer) Infof(format string, args ...interface{}) {
+	o.log(bark.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+func (o *observer) Warn(args ...interface{}) { o.log(bark.WarnLevel, fmt.Sprint(args...)) }
+
+func (o *observer) Warnf(format string, args ...interface{}) {
+	o.log(bark.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+func (o *observer) Error(args ...interface{}) { o.log(bark.ErrorLevel, fmt.Sprint(args...)) }
+
+func (o *observer) Errorf(format string, args ...interface{}) {
+	o.log(bark.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+func (o *observer) Fatal(args ...interface{}) {
+	o.log(bark.FatalLevel, fmt.Sprint(args...))
+	o.exit(1)
+}
+
+func (o *observer) Fatalf(format string, args ...interface{}) {
+	o.log(bark.FatalLevel, fmt.Sprintf(format, args...))
+	o.exit(1)
+}
+
+func (o *observer) Panic(args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	o.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (o *observer) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	o.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (o *observer) Log(level bark.Level, args ...interface{}) {
+	o.log(level, fmt.Sprint(args...))
+}
+
+func (o *observer) Logf(level bark.Level, format string, args ...interface{}) {
+	o.log(level, fmt.Sprintf(format, args...))
+}
+
+func (o *observer) WithField(key string, value interface{}) bark.Logger {
+	return o.with(bark.Fields{key: value}, o.err)
+}
+
+func (o *observer) WithFields(keyValues bark.LogFields) bark.Logger {
+	if keyValues == nil {
+		return o
+	}
+	return o.with(keyValues.Fields(), o.err)
+}
+
+func (o *observer) WithError(err error) bark.Logger {
+	return o.with(bark.Fields{errorKey: err}, err)
+}
+
+// Named records the logger's dotted name in the bark.LoggerNameKey field.
+func (o *observer) Named(name string) bark.Logger {
+	if name == "" {
+		return o
+	}
+	if parent, ok := o.fields[bark.LoggerNameKey].(string); ok && parent != "" {
+		name = parent + "." + name
+	}
+	return o.with(bark.Fields{bark.LoggerNameKey: name}, o.err)
+}
+
+func (o *observer) AddCallerSkip(skip int) bark.Logger {
+	clone := *o
+	clone.skip += skip
+	return &clone
+}
+
+func (o *observer) Fields() bark.Fields {
+	return o.fields
+}
+
+func (o *observer) Enabled(level bark.Level) bool {
+	return o.level.Enabled(level)
+}
+
+func (o *observer) Level() bark.Level {
+	return o.level
+}
+
+// errorKey is the field name used by WithError, matching logrus.ErrorKey.
+const errorKey = "error"
+
+func (o *observer) with(fields map[string]interface{}, err error) *observer {
+	merged := make(bark.Fields, len(o.fields)+len(fields))
+	for k, v := range o.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	clone := *o
+	clone.fields = merged
+	clone.err = err
+	return &clone
+}
+
+// log must be called directly by a bark.Logger method so that the caller is
+// reported correctly.
+func (o *observer) log(level bark.Level, msg string) {
+	if !o.Enabled(level) {
+		return
+	}
+
+	o.logs.add(LoggedEntry{
+		Level:   level,
+		Time:    time.Now(),
+		Message: msg,
+		Fields:  o.fields,
+		Error:   o.err,
+		Caller:  callerAt(3 + o.skip),
+	})
+}
+
+func callerAt(skip int) Caller {
+	pc, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return Caller{}
+	}
+
+	c := Caller{Defined: true, File: file, Line: line}
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		c.Function = fn.Name()
+	}
+	return c
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barktest_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+func TestObserverLevels(t *testing.T) {
+	logger, logs := barktest.New(bark.InfoLevel)
+	logger.Debug("debug")
+	logger.Debugf("debug%s", "f")
+	logger.Info("info", 1)
+	logger.Infof("info%s", "f")
+	logger.Warn("warn")
+	logger.Warnf("warn%s", "f")
+	logger.Error("error")
+	logger.Errorf("error%s", "f")
+
+	var got []string
+	for _, e := range logs.AllUntimed() {
+		got = append(got, e.Level.String()+": "+e.Message)
+	}
+	assert.Equal(t, []string{
+		"info: info1",
+		"info: infof",
+		"warn: warn",
+		"warn: warnf",
+		"error: error",
+		"error: errorf",
+	}, got, "unexpected entries")
+
+	levels, ok := logger.(bark.LevelEnabler)
+	require.True(t, ok, "observer should implement bark.LevelEnabler")
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.DebugLevel))
+	assert.True(t, levels.Enabled(bark.InfoLevel))
+}
+
+func TestObserverPanic(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	assert.PanicsWithValue(t, "oh no", func() { logger.Panic("oh ", "no") })
+	assert.PanicsWithValue(t, "oh no", func() { logger.Panicf("oh %s", "no") })
+	assert.Equal(t, 2, logs.FilterLevel(bark.PanicLevel).FilterMessage("oh no").Len())
+}
+
+func TestObserverFatal(t *testing.T) {
+	var codes []int
+	logger, logs := barktest.New(bark.DebugLevel, bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+	logger.Fatal("oh ", "no")
+	logger.WithField("foo", "bar").Fatalf("oh %s", "no")
+
+	assert.Equal(t, []int{1, 1}, codes, "expected the exit function to be called after each entry")
+	assert.Equal(t, 2, logs.FilterLevel(bark.FatalLevel).FilterMessage("oh no").Len())
+}
+
+func TestObserverLevelLogger(t *testing.T) {
+	logger, logs := barktest.New(bark.InfoLevel)
+	levels, ok := logger.(bark.LevelLogger)
+	require.True(t, ok, "expected observer to implement LevelLogger")
+
+	levels.Log(bark.DebugLevel, "disabled")
+	assert.NotPanics(t, func() { levels.Log(bark.PanicLevel, "oh ", "no") })
+	levels.Logf(bark.FatalLevel, "oh %s", "no")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	assert.Equal(t, bark.PanicLevel, entries[0].Level)
+	assert.Equal(t, bark.FatalLevel, entries[1].Level)
+	for _, e := range entries {
+		assert.Equal(t, "oh no", e.Message)
+		assert.Equal(t, "observer_test.go", filepath.Base(e.Caller.File), "unexpected caller file")
+	}
+}
+
+func TestObserverFields(t *testing.T) {
+	err := errors.New("great sadness")
+	logger, logs := barktest.New(bark.DebugLevel)
+	assert.Nil(t, logger.Fields(), "expected no fields on a fresh logger")
+	assert.Equal(t, logger, logger.WithFields(nil), "expected nil fields to be a no-op")
+
+	parent := logger.WithFields(bark.Fields{"foo": "bar", "baz": 1})
+	child := parent.WithField("foo", "qux").WithError(err)
+	assert.Equal(t, bark.Fields{"foo": "bar", "baz": 1}, parent.Fields(), "parent fields changed")
+	assert.Equal(t, bark.Fields{"foo": "qux", "baz": 1, "error": err}, child.Fields())
+
+	parent.Info("parent")
+	child.Info("child")
+
+	entries := logs.TakeAll()
+	require.Len(t, entries, 2)
+	assert.Equal(t, bark.Fields{"foo": "bar", "baz": 1}, entries[0].Fields)
+	assert.Nil(t, entries[0].Error)
+	assert.Equal(t, bark.Fields{"foo": "qux", "baz": 1, "error": err}, entries[1].Fields)
+	assert.Equal(t, err, entries[1].Error)
+	assert.Equal(t, 0, logs.Len(), "expected TakeAll to truncate entries")
+}
+
+func TestObserverNamed(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	named, ok := logger.(bark.NamedLogger)
+	require.True(t, ok, "expected observer to implement NamedLogger")
+	assert.Equal(t, logger, named.Named(""), "expected empty names to be a no-op")
+
+	named.Named("storage").WithField("foo", "bar").(bark.NamedLogger).Named("cache").Info("hello")
+	assert.Equal(t, 1, logs.FilterField(bark.LoggerNameKey, "storage.cache").FilterField("foo", "bar").Len())
+}
+
+func TestObserverCaller(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger.Info("hello")
+	logger.WithField("foo", "bar").Errorf("hello %s", "world")
+
+	for _, e := range logs.All() {
+		assert.True(t, e.Caller.Defined, "expected caller to be defined")
+		assert.Equal(t, "observer_test.go", filepath.Base(e.Caller.File), "unexpected caller file")
+		assert.Contains(t, e.Caller.Function, "TestObserverCaller", "unexpected caller function")
+	}
+	assert.Equal(t, "undefined", barktest.Caller{}.String())
+}
+
+func TestObserverCallerSkip(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	skipper, ok := logger.(bark.CallerSkipper)
+	require.True(t, ok, "expected observer to implement CallerSkipper")
+
+	logVia := func(l bark.Logger) { l.WithField("foo", "bar").Info("hello") }
+	logVia(skipper.AddCallerSkip(1))
+	logVia(logger)
+
+	entries := logs.All()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "github.com/uber-common/bark/barktest_test.TestObserverCallerSkip", entries[0].Caller.Function,
+		"expected the helper's frame to be skipped")
+	assert.Equal(t, "github.com/uber-common/bark/barktest_test.TestObserverCallerSkip.func1", entries[1].Caller.Function,
+		"expected skips not to affect the parent logger")
+}
+
+func TestObservedLogsFilters(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger.WithField("user", "alice").Info("login succeeded")
+	logger.WithField("user", "bob").Warn("login failed")
+	logger.WithFields(bark.Fields{"user": "bob", "attempts": 3}).Error("account locked")
+
+	tests := []struct {
+		desc     string
+		filtered *barktest.ObservedLogs
+		want     []string
+	}{
+		{"level", logs.FilterLevel(bark.WarnLevel), []string{"login failed"}},
+		{"message", logs.FilterMessage("login failed"), []string{"login failed"}},
+		{"snippet", logs.FilterMessageSnippet("login"), []string{"login succeeded", "login failed"}},
+		{"field", logs.FilterField("user", "bob"), []string{"login failed", "account locked"}},
+		{"field key", logs.FilterFieldKey("attempts"), []string{"account locked"}},
+		{"field missing", logs.FilterField("user", "carol"), nil},
+		{"chained", logs.FilterField("user", "bob").FilterLevel(bark.ErrorLevel), []string{"account locked"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var got []string
+			for _, e := range tt.filtered.All() {
+				got = append(got, e.Message)
+			}
+			assert.Equal(t, tt.want, got, "unexpected filtered entries")
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barktest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+)
+
+// RunLoggerSuite verifies that a bark.Logger implementation behaves like the
+// logrus wrapper returned by bark.NewLoggerFromLogrus. newLogger is called
+// once per test and must return a fresh logger with no fields attached.
+//
+// The suite doesn't inspect output, so the loggers may discard it, but they
+// must not exit the process or otherwise fail on Debug through Error entries.
+func RunLoggerSuite(t *testing.T, newLogger func() bark.Logger) {
+	t.Run("LogsAtEveryLevel", func(t *testing.T) {
+		l := newLogger()
+		assert.NotPanics(t, func() {
+			l.Debug("debug", 1)
+			l.Debugf("debug%s", "f")
+			l.Info("info", 1)
+			l.Infof("info%s", "f")
+			l.Warn("warn", 1)
+			l.Warnf("warn%s", "f")
+			l.Error("error", 1)
+			l.Errorf("error%s", "f")
+		}, "logging below fatal and panic levels must not panic")
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		l := newLogger()
+		assert.Panics(t, func() { l.Panic("panic") }, "Panic must panic")
+		assert.Panics(t, func() { l.Panicf("panic%s", "f") }, "Panicf must panic")
+		assert.Panics(t, func() { l.WithField("foo", "bar").Panic("panic") }, "Panic must panic with fields set")
+		assert.NotPanics(t, func() { l.Info("still usable") }, "logger must be usable after recovering from Panic")
+	})
+
+	t.Run("NoFields", func(t *testing.T) {
+		assert.Nil(t, newLogger().Fields(), "a fresh logger must return nil fields")
+	})
+
+	t.Run("NilLogFields", func(t *testing.T) {
+		l := newLogger()
+		require.NotPanics(t, func() { l = l.WithFields(nil) }, "WithFields(nil) must not panic")
+		assert.Nil(t, l.Fields(), "WithFields(nil) must not add fields")
+
+		l = l.WithField("foo", "bar").WithFields(nil)
+		assert.Equal(t, bark.Fields{"foo": "bar"}, l.Fields(), "WithFields(nil) must keep existing fields")
+	})
+
+	t.Run("EmptyLogFields", func(t *testing.T) {
+		assert.Empty(t, newLogger().WithFields(bark.Fields{}).Fields(), "WithFields of an empty map must not add fields")
+	})
+
+	t.Run("WithField", func(t *testing.T) {
+		l := newLogger().WithField("foo", "bar")
+		assert.Equal(t, bark.Fields{"foo": "bar"}, l.Fields())
+
+		l = l.WithField("baz", 42)
+		assert.Equal(t, bark.Fields{"foo": "bar", "baz": 42}, l.Fields(), "fields must accumulate")
+
+		l = l.WithField("foo", "qux")
+		assert.Equal(t, bark.Fields{"foo": "qux", "baz": 42}, l.Fields(), "later fields must replace earlier ones")
+	})
+
+	t.Run("WithFields", func(t *testing.T) {
+		l := newLogger().WithFields(bark.Fields{"foo": "bar", "baz": "bump"})
+		assert.Equal(t, bark.Fields{"foo": "bar", "baz": "bump"}, l.Fields())
+
+		l = l.WithFields(bark.Fields{"foo": "qux", "a": "b"})
+		assert.Equal(t, bark.Fields{"foo": "qux", "baz": "bump", "a": "b"}, l.Fields(),
+			"fields must be merged, with later fields replacing earlier ones")
+	})
+
+	t.Run("CustomLogFields", func(t *testing.T) {
+		l := newLogger().WithFields(customLogFields{"foo": "bar"})
+		assert.Equal(t, bark.Fields{"foo": "bar"}, l.Fields(), "any bark.LogFields implementation must be accepted")
+	})
+
+	t.Run("WithError", func(t *testing.T) {
+		err := errors.New("great sadness")
+		l := newLogger().WithField("foo", "bar").WithError(err)
+		assert.Equal(t, bark.Fields{"foo": "bar", "error": err}, l.Fields(), `errors must be stored under the "error" key`)
+
+		other := errors.New("greater sadness")
+		assert.Equal(t, other, l.WithError(other).Fields()["error"], "WithError must replace the previous error")
+	})
+
+	t.Run("ParentImmutable", func(t *testing.T) {
+		parent := newLogger().WithField("foo", "bar")
+		parent.WithField("baz", "bump")
+		parent.WithFields(bark.Fields{"foo": "qux", "a": "b"})
+		parent.WithError(errors.New("great sadness"))
+		assert.Equal(t, bark.Fields{"foo": "bar"}, parent.Fields(), "deriving loggers must not modify the parent")
+
+		root := newLogger()
+		root.WithField("foo", "bar")
+		assert.Nil(t, root.Fields(), "deriving loggers must not modify the root")
+	})
+
+	t.Run("CallerFieldsCopied", func(t *testing.T) {
+		fields := bark.Fields{"foo": "bar"}
+		l := newLogger().WithFields(fields)
+		fields["foo"] = "qux"
+		fields["baz"] = "bump"
+		assert.Equal(t, bark.Fields{"foo": "bar"}, l.Fields(), "loggers must not retain the caller's map")
+	})
+
+	t.Run("LevelEnabler", func(t *testing.T) {
+		levels, ok := newLogger().(bark.LevelEnabler)
+		if !ok {
+			t.Skip("logger doesn't implement bark.LevelEnabler")
+		}
+
+		min := levels.Level()
+		for lvl := bark.DebugLevel; lvl <= bark.ErrorLevel; lvl++ {
+			assert.Equal(t, lvl >= min, levels.Enabled(lvl),
+				fmt.Sprintf("Enabled(%v) must agree with Level() = %v", lvl, min))
+		}
+	})
+}
+
+// customLogFields is a bark.LogFields implementation other than bark.Fields.
+type customLogFields map[string]interface{}
+
+func (f customLogFields) Fields() map[string]interface{} { return f }
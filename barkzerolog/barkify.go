@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkzerolog
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/uber-common/bark"
+)
+
+// _errorKey is the field name used by WithError, matching logrus.ErrorKey.
+const _errorKey = "error"
+
+// _barkifyCallerSkip is the number of stack frames between the caller and
+// zerolog for every bark.Logger method: the method itself and log.
+const _barkifyCallerSkip = 2
+
+// Barkify wraps a zerolog.Logger so that it satisfies the bark.Logger
+// interface. Since zerolog can't reconstruct fields from its encoded context,
+// the wrapper keeps its own copy of the fields added through WithField,
+// WithFields and WithError to return from the Fields method; fields added to
+// the zerolog logger before it was wrapped aren't included. Fields are added
+// in sorted order by WithFields.
+//
+// Fatal and Fatalf write the entry, then exit the process with os.Exit, as
+// zerolog does; use bark.WithExitFunc and bark.WithPreExitHook to change what
+// happens after a Fatal entry.
+//
+// The returned logger also implements bark.LevelEnabler, bark.LevelLogger and
+// bark.CallerSkipper.
+func Barkify(l zerolog.Logger, opts ...bark.ExitOption) bark.Logger {
+	return &barker{l: l, exit: bark.NewExitFunc(os.Exit, opts...)}
+}
+
+type barker struct {
+	l    zerolog.Logger
+	exit func(code int)
+	skip int
+
+	// fields mirrors the context added through the bark.Logger interface so
+	// that it can be returned from Fields. It's copied on write, never
+	// modified in place.
+	fields bark.Fields
+}
+
+func (b *barker) Debug(args ...interface{}) { b.log(bark.DebugLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Debugf(format string, args ...interface{}) {
+	b.log(bark.DebugLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Info(args ...interface{}) { b.log(bark.InfoLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Infof(format string, args ...interface{}) {
+	b.log(bark.InfoLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Warn(args ...interface{}) { b.log(bark.WarnLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Warnf(format string, args ...interface{}) {
+	b.log(bark.WarnLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Error(args ...interface{}) { b.log(bark.ErrorLevel, fmt.Sprint(args...)) }
+
+func (b *barker) Errorf(format string, args ...interface{}) {
+	b.log(bark.ErrorLevel, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) Fatal(args ...interface{}) {
+	b.log(bark.FatalLevel, fmt.Sprint(args...))
+	b.exit(1)
+}
+
+func (b *barker) Fatalf(format string, args ...interface{}) {
+	b.log(bark.FatalLevel, fmt.Sprintf(format, args...))
+	b.exit(1)
+}
+
+func (b *barker) Panic(args ...interface{}) {
+	msg := fmt.Sprint(args...)
+	b.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (b *barker) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	b.log(bark.PanicLevel, msg)
+	panic(msg)
+}
+
+func (b *barker) Log(level bark.Level, args ...interface{}) {
+	b.log(level, fmt.Sprint(args...))
+}
+
+func (b *barker) Logf(level bark.Level, format string, args ...interface{}) {
+	b.log(level, fmt.Sprintf(format, args...))
+}
+
+func (b *barker) WithField(key string, value interface{}) bark.Logger {
+	return b.with(bark.Fields{key: value}, addField(b.l.With(), key, value))
+}
+
+func (b *barker) WithFields(keyValues bark.LogFields) bark.Logger {
+	if keyValues == nil {
+		return b
+	}
+	fields := keyValues.Fields()
+
+	// Deterministic ordering of fields.
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	c := b.l.With()
+	for _, k := range keys {
+		c = addField(c, k, fields[k])
+	}
+	return b.with(fields, c)
+}
+
+func (b *barker) WithError(err error) bark.Logger {
+	return b.with(bark.Fields{_errorKey: err}, b.l.With().AnErr(_errorKey, err))
+}
+
+func (b *barker) AddCallerSkip(skip int) bark.Logger {
+	clone := *b
+	clone.skip += skip
+	return &clone
+}
+
+func (b *barker) Fields() bark.Fields {
+	return b.fields
+}
+
+func (b *barker) Enabled(level bark.Level) bool {
+	lvl := toZerologLevel(level)
+	return lvl >= b.l.GetLevel() && lvl >= zerolog.GlobalLevel()
+}
+
+func (b *barker) Level() bark.Level {
+	for lvl := bark.DebugLevel; lvl < bark.FatalLevel; lvl++ {
+		if b.Enabled(lvl) {
+			return lvl
+		}
+	}
+	return bark.FatalLevel
+}
+
+func (b *barker) with(fields map[string]interface{}, c zerolog.Context) *barker {
+	merged := make(bark.Fields, len(b.fields)+len(fields))
+	for k, v := range b.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	clone := *b
+	clone.l = c.Logger()
+	clone.fields = merged
+	return &clone
+}
+
+// log must be called directly by a bark.Logger method so that the caller is
+// reported correctly.
+func (b *barker) log(level bark.Level, msg string) {
+	// Unlike zerolog's Panic and Fatal methods, WithLevel never panics or
+	// exits, so bark's exit options apply.
+	b.l.WithLevel(toZerologLevel(level)).CallerSkipFrame(_barkifyCallerSkip + b.skip).Msg(msg)
+}
+
+// addField adds a bark field to a zerolog context.
+//
+// This relies on zerolog's typed methods for common types, and falls back to
+// Interface, which uses encoding/json like logrus does, for all others.
+func addField(c zerolog.Context, key string, v interface{}) zerolog.Context {
+	switch val := v.(type) {
+
+	// Types that implement LogObjectMarshaler or LogArrayMarshaler are
+	// explicitly specifying how they want to be logged with zerolog.
+	case zerolog.LogObjectMarshaler:
+		return c.Object(key, val)
+	case zerolog.LogArrayMarshaler:
+		return c.Array(key, val)
+
+	case bool:
+		return c.Bool(key, val)
+	case []bool:
+		return c.Bools(key, val)
+
+	case float32:
+		return c.Float32(key, val)
+	case []float32:
+		return c.Floats32(key, val)
+
+	case float64:
+		return c.Float64(key, val)
+	case []float64:
+		return c.Floats64(key, val)
+
+	case int:
+		return c.Int(key, val)
+	case []int:
+		return c.Ints(key, val)
+
+	case int8:
+		return c.Int8(key, val)
+	case []int8:
+		return c.Ints8(key, val)
+
+	case int16:
+		return c.Int16(key, val)
+	case []int16:
+		return c.Ints16(key, val)
+
+	case int32:
+		return c.Int32(key, val)
+	case []int32:
+		return c.Ints32(key, val)
+
+	case int64:
+		return c.Int64(key, val)
+	case []int64:
+		return c.Ints64(key, val)
+
+	case string:
+		return c.Str(key, val)
+	case []string:
+		return c.Strs(key, val)
+
+	case uint:
+		return c.Uint(key, val)
+	case []uint:
+		return c.Uints(key, val)
+
+	case uint8:
+		return c.Uint8(key, val)
+	// []uint8 == []byte, which logrus encodes in base64, so it falls back to
+	// Interface.
+
+	case uint16:
+		return c.Uint16(key, val)
+	case []uint16:
+		return c.Uints16(key, val)
+
+	case uint32:
+		return c.Uint32(key, val)
+	case []uint32:
+		return c.Uints32(key, val)
+
+	case uint64:
+		return c.Uint64(key, val)
+	case []uint64:
+		return c.Uints64(key, val)
+
+	case time.Time:
+		return c.Time(key, val)
+	case []time.Time:
+		return c.Times(key, val)
+
+	// Logrus logs time.Duration as numbers so we should do the
+	// same.
+	case time.Duration:
+		return c.Int64(key, int64(val))
+	case []time.Duration:
+		ds := make([]int64, len(val))
+		for i, d := range val {
+			ds[i] = int64(d)
+		}
+		return c.Ints64(key, ds)
+
+	case error:
+		return c.AnErr(key, val)
+	case []error:
+		return c.Errs(key, val)
+	}
+
+	// Use Interface for everything else.
+	return c.Interface(key, v)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkzerolog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+	"github.com/uber-common/bark/barkzerolog"
+)
+
+func newTestLogger(level zerolog.Level, opts ...bark.ExitOption) (bark.Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf).Level(level).With().Caller().Logger()
+	return barkzerolog.Barkify(zl, opts...), buf
+}
+
+func decode(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var m map[string]interface{}
+		require.NoError(t, dec.Decode(&m))
+		entries = append(entries, m)
+	}
+	return entries
+}
+
+func TestBarkifyLevels(t *testing.T) {
+	l, buf := newTestLogger(zerolog.InfoLevel)
+	l.Debug("debug")
+	l.Infof("info %d", 1)
+	l.Warn("warn")
+	l.Errorf("error %d", 1)
+	assert.PanicsWithValue(t, "panic", func() { l.Panic("panic") })
+
+	var got []string
+	for _, e := range decode(t, buf) {
+		got = append(got, e["level"].(string)+": "+e["message"].(string))
+	}
+	assert.Equal(t, []string{"info: info 1", "warn: warn", "error: error 1", "panic: panic"}, got)
+
+	levels, ok := l.(bark.LevelEnabler)
+	require.True(t, ok, "expected Barkify to return a LevelEnabler")
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.DebugLevel))
+	assert.True(t, levels.Enabled(bark.FatalLevel))
+}
+
+func TestBarkifyGlobalLevel(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+
+	l, buf := newTestLogger(zerolog.DebugLevel)
+	l.Info("disabled")
+	assert.Equal(t, 0, buf.Len(), "expected the global level to apply")
+	assert.Equal(t, bark.WarnLevel, l.(bark.LevelEnabler).Level())
+}
+
+func TestBarkifyFields(t *testing.T) {
+	l, buf := newTestLogger(zerolog.DebugLevel)
+	err := errors.New("oh no")
+
+	child := l.WithFields(bark.Fields{"b": 2, "a": 1}).WithField("c", "x").WithError(err)
+	assert.Equal(t, bark.Fields{"a": 1, "b": 2, "c": "x", "error": err}, child.Fields())
+	assert.Nil(t, l.Fields(), "expected the parent's fields to be unchanged")
+	child.Info("hello")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, float64(1), entries[0]["a"])
+	assert.Equal(t, float64(2), entries[0]["b"])
+	assert.Equal(t, "x", entries[0]["c"])
+	assert.Equal(t, "oh no", entries[0]["error"])
+}
+
+type user struct{ name string }
+
+func (u user) MarshalZerologObject(e *zerolog.Event) { e.Str("name", u.name) }
+
+func TestBarkifyFieldTypes(t *testing.T) {
+	when := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		desc  string
+		value interface{}
+		want  interface{}
+	}{
+		{"bool", true, true},
+		{"bools", []bool{true, false}, []interface{}{true, false}},
+		{"float32", float32(1.5), 1.5},
+		{"float64", 2.5, 2.5},
+		{"int", 1, float64(1)},
+		{"int8", int8(-8), float64(-8)},
+		{"int16s", []int16{1, 2}, []interface{}{float64(1), float64(2)}},
+		{"int64", int64(64), float64(64)},
+		{"string", "foo", "foo"},
+		{"strings", []string{"foo", "bar"}, []interface{}{"foo", "bar"}},
+		{"uint", uint(1), float64(1)},
+		{"uint8", uint8(8), float64(8)},
+		{"uint64s", []uint64{1}, []interface{}{float64(1)}},
+		{"bytes", []byte("foo"), "Zm9v"},
+		{"time", when, "2026-10-18T00:00:00Z"},
+		{"duration", time.Second, float64(time.Second)},
+		{"durations", []time.Duration{time.Millisecond}, []interface{}{float64(time.Millisecond)}},
+		{"error", errors.New("oh no"), "oh no"},
+		{"errors", []error{errors.New("oh no")}, []interface{}{"oh no"}},
+		{"object marshaler", user{"alice"}, map[string]interface{}{"name": "alice"}},
+		{"struct", struct{ Name string }{"bob"}, map[string]interface{}{"Name": "bob"}},
+		{"nil", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			l, buf := newTestLogger(zerolog.DebugLevel)
+			l.WithField("value", tt.value).Info("hello")
+
+			entries := decode(t, buf)
+			require.Len(t, entries, 1)
+			assert.Equal(t, tt.want, entries[0]["value"], "unexpected encoded value")
+		})
+	}
+}
+
+func TestBarkifyCaller(t *testing.T) {
+	l, buf := newTestLogger(zerolog.DebugLevel)
+	l.Info("hello")
+	l.WithField("foo", "bar").Errorf("hello %s", "world")
+	l.(bark.LevelLogger).Log(bark.WarnLevel, "hello")
+	assert.Panics(t, func() { l.Panic("hello") })
+
+	logVia := func(l bark.Logger) { l.Info("hello") }
+	_, _, line, _ := runtime.Caller(0)
+	logVia(l.(bark.CallerSkipper).AddCallerSkip(1))
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 5)
+	for _, e := range entries {
+		assert.Regexp(t, `^barkify_test\.go:\d+$`, path.Base(e["caller"].(string)), "incorrect caller")
+	}
+	assert.Equal(t, fmt.Sprintf("barkify_test.go:%d", line+1), path.Base(entries[4]["caller"].(string)),
+		"expected the helper's frame to be skipped")
+}
+
+func TestBarkifyLevelLogger(t *testing.T) {
+	l, buf := newTestLogger(zerolog.InfoLevel)
+	levels, ok := l.(bark.LevelLogger)
+	require.True(t, ok, "expected Barkify to return a LevelLogger")
+
+	levels.Log(bark.DebugLevel, "disabled")
+	assert.NotPanics(t, func() { levels.Log(bark.PanicLevel, "oh ", "no") })
+	levels.Logf(bark.FatalLevel, "oh %s", "no")
+
+	entries := decode(t, buf)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "panic", entries[0]["level"])
+	assert.Equal(t, "fatal", entries[1]["level"])
+}
+
+func TestBarkifyFatal(t *testing.T) {
+	var codes []int
+	l, buf := newTestLogger(zerolog.InfoLevel, bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+	l.Fatal("oh ", "no")
+	l.Fatalf("oh %s", "no")
+
+	assert.Equal(t, []int{1, 1}, codes, "expected the exit function to be called after each entry")
+	for _, e := range decode(t, buf) {
+		assert.Equal(t, "fatal", e["level"])
+		assert.Equal(t, "oh no", e["message"])
+	}
+}
+
+func TestBarkifyConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		l, _ := newTestLogger(zerolog.DebugLevel)
+		return l
+	})
+}
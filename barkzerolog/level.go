@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/uber-common/bark"
+)
+
+// toZerologLevel converts a bark level to the equivalent zerolog level.
+func toZerologLevel(lvl bark.Level) zerolog.Level {
+	switch lvl {
+	case bark.DebugLevel:
+		return zerolog.DebugLevel
+	case bark.InfoLevel:
+		return zerolog.InfoLevel
+	case bark.WarnLevel:
+		return zerolog.WarnLevel
+	case bark.ErrorLevel:
+		return zerolog.ErrorLevel
+	case bark.PanicLevel:
+		return zerolog.PanicLevel
+	case bark.FatalLevel:
+		return zerolog.FatalLevel
+	}
+
+	if lvl < bark.DebugLevel {
+		return zerolog.DebugLevel
+	}
+	return zerolog.FatalLevel
+}
+
+// toBarkLevel converts a zerolog level to the bark level it is logged at.
+// Trace entries are logged at debug level, and entries without a level at
+// info level.
+func toBarkLevel(lvl zerolog.Level) bark.Level {
+	switch lvl {
+	case zerolog.TraceLevel, zerolog.DebugLevel:
+		return bark.DebugLevel
+	case zerolog.InfoLevel, zerolog.NoLevel:
+		return bark.InfoLevel
+	case zerolog.WarnLevel:
+		return bark.WarnLevel
+	case zerolog.ErrorLevel:
+		return bark.ErrorLevel
+	case zerolog.PanicLevel:
+		return bark.PanicLevel
+	case zerolog.FatalLevel:
+		return bark.FatalLevel
+	}
+
+	if lvl < zerolog.TraceLevel {
+		return bark.DebugLevel
+	}
+	return bark.FatalLevel
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkzerolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"github.com/uber-common/bark"
+)
+
+// NewLogger creates a zerolog.Logger that writes entries to a bark.Logger.
+// Entries are decoded from zerolog's JSON output: fields become bark fields,
+// and the error field is added with WithError. Entries' timestamps and
+// levels are dropped, since the bark logger records its own.
+//
+// zerolog panics or exits after writing panic and fatal entries, so the bark
+// logger only writes them: at their own level if it implements
+// bark.LevelLogger, and at error level otherwise. If the bark logger
+// implements bark.LevelEnabler, the zerolog logger's level is set to match.
+// If l was created by Barkify, the original zerolog.Logger is returned.
+func NewLogger(l bark.Logger) zerolog.Logger {
+	if b, ok := l.(*barker); ok {
+		return b.l
+	}
+
+	zl := zerolog.New(&writer{l: l})
+	if levels, ok := l.(bark.LevelEnabler); ok {
+		lvl := toZerologLevel(levels.Level())
+		if lvl == zerolog.DebugLevel {
+			lvl = zerolog.TraceLevel // trace entries are written at debug level
+		}
+		zl = zl.Level(lvl)
+	}
+	return zl
+}
+
+// writer is a zerolog.LevelWriter that decodes entries and writes them to a
+// bark.Logger.
+type writer struct {
+	l bark.Logger
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *writer) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	var fields bark.Fields
+	dec := json.NewDecoder(bytes.NewReader(p))
+	dec.UseNumber() // keep integers exact
+	if err := dec.Decode(&fields); err != nil {
+		return 0, err
+	}
+
+	msg, _ := fields[zerolog.MessageFieldName].(string)
+	delete(fields, zerolog.MessageFieldName)
+	delete(fields, zerolog.LevelFieldName)
+	delete(fields, zerolog.TimestampFieldName)
+
+	logger := w.l
+	if errMsg, ok := fields[zerolog.ErrorFieldName].(string); ok {
+		delete(fields, zerolog.ErrorFieldName)
+		logger = logger.WithError(errors.New(errMsg))
+	}
+	if len(fields) > 0 {
+		logger = logger.WithFields(fields)
+	}
+
+	switch lvl := toBarkLevel(level); lvl {
+	case bark.DebugLevel:
+		logger.Debug(msg)
+	case bark.InfoLevel:
+		logger.Info(msg)
+	case bark.WarnLevel:
+		logger.Warn(msg)
+	case bark.ErrorLevel:
+		logger.Error(msg)
+	default:
+		if ll, ok := logger.(bark.LevelLogger); ok {
+			ll.Log(lvl, msg)
+		} else {
+			logger.Error(msg)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package barkzerolog_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+	"github.com/uber-common/bark/barkzerolog"
+)
+
+// plainLogger hides the optional interfaces implemented by the logger it wraps.
+type plainLogger struct{ bark.Logger }
+
+func TestNewLoggerLevels(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	zl := barkzerolog.NewLogger(logger)
+	zl.Trace().Msg("trace")
+	zl.Debug().Msg("debug")
+	zl.Info().Msg("info")
+	zl.Log().Msg("no level")
+	zl.Warn().Msg("warn")
+	zl.Error().Msg("error")
+	zl.WithLevel(zerolog.PanicLevel).Msg("panic")
+	zl.WithLevel(zerolog.FatalLevel).Msg("fatal")
+	assert.PanicsWithValue(t, "oh no", func() { zl.Panic().Msg("oh no") })
+
+	var got []string
+	for _, e := range logs.AllUntimed() {
+		got = append(got, e.Level.String()+": "+e.Message)
+	}
+	assert.Equal(t, []string{
+		"debug: trace",
+		"debug: debug",
+		"info: info",
+		"info: no level",
+		"warn: warn",
+		"error: error",
+		"panic: panic",
+		"fatal: fatal",
+		"panic: oh no",
+	}, got, "unexpected entries")
+}
+
+func TestNewLoggerLevel(t *testing.T) {
+	logger, logs := barktest.New(bark.WarnLevel)
+	zl := barkzerolog.NewLogger(logger)
+	assert.Equal(t, zerolog.WarnLevel, zl.GetLevel())
+	zl.Info().Msg("disabled")
+	assert.Equal(t, 0, logs.Len())
+
+	logger, _ = barktest.New(bark.DebugLevel)
+	assert.Equal(t, zerolog.TraceLevel, barkzerolog.NewLogger(logger).GetLevel(), "expected trace entries to be enabled at debug level")
+	assert.Equal(t, zerolog.TraceLevel, barkzerolog.NewLogger(plainLogger{logger}).GetLevel(),
+		"expected every level to be enabled without LevelEnabler")
+}
+
+func TestNewLoggerPanicWithoutLevelLogger(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	zl := barkzerolog.NewLogger(plainLogger{logger})
+	zl.WithLevel(zerolog.FatalLevel).Msg("fatal")
+	assert.Equal(t, 1, logs.FilterLevel(bark.ErrorLevel).FilterMessage("fatal").Len(),
+		"expected fatal entries at error level without LevelLogger")
+}
+
+func TestNewLoggerFields(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	zl := barkzerolog.NewLogger(logger).With().Str("foo", "bar").Timestamp().Logger()
+	zl.Error().Int("attempt", 3).Err(errors.New("oh no")).Msg("failed")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "failed", entries[0].Message)
+	assert.EqualError(t, entries[0].Error, "oh no")
+	assert.Equal(t, "bar", entries[0].Fields["foo"])
+	assert.Equal(t, json.Number("3"), entries[0].Fields["attempt"], "expected integers to be kept exact")
+	assert.NotContains(t, entries[0].Fields, zerolog.TimestampFieldName, "expected timestamps to be dropped")
+	assert.NotContains(t, entries[0].Fields, zerolog.LevelFieldName, "expected levels to be dropped")
+}
+
+func TestRoundTrip(t *testing.T) {
+	zl := zerolog.Nop()
+	assert.Equal(t, zl, barkzerolog.NewLogger(barkzerolog.Barkify(zl)), "expected NewLogger to unwrap loggers created by Barkify")
+}
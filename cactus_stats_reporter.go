@@ -19,27 +19,340 @@
 package bark
 
 import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cactus/go-statsd-client/statsd"
 )
 
+// TagFormat selects how a StatsReporter created by NewStatsReporterFromCactus
+// encodes tags into the metrics it emits, since plain statsd has no notion of tags.
+// Tags are always encoded in key order, so each tag set maps to a single series.
+type TagFormat int
+
+const (
+	// TagFormatNone drops tags. This is the default.
+	TagFormatNone TagFormat = iota
+
+	// TagFormatDogStatsD appends tags to the value, as in "name:1|c|#k1:v1,k2:v2".
+	// Commas, pipes and hashes in keys and values (and colons in keys) are replaced by underscores.
+	TagFormatDogStatsD
+
+	// TagFormatInfluxDB appends tags to the name, as in "name,k1=v1,k2=v2:1|c".
+	// Commas, equals signs and spaces in keys and values are escaped with a backslash,
+	// and characters reserved by statsd are replaced by underscores.
+	TagFormatInfluxDB
+
+	// TagFormatGraphite appends tag keys and values to the name as path components,
+	// as in "name.k1.v1.k2.v2:1|c". Dots, spaces and characters reserved by statsd
+	// in keys and values are replaced by underscores.
+	TagFormatGraphite
+)
+
+// MetricKind identifies a kind of metric, for options that apply to a single kind.
+type MetricKind int
+
+const (
+	// CounterMetric identifies counters, reported by IncCounter.
+	CounterMetric MetricKind = iota
+	// GaugeMetric identifies gauges, reported by UpdateGauge.
+	GaugeMetric
+	// TimerMetric identifies timers, reported by RecordTimer.
+	TimerMetric
+	// HistogramMetric identifies histograms, reported by RecordHistogram.
+	HistogramMetric
+)
+
+// HistogramFormat selects the statsd metric type used by a StatsReporter created by
+// NewStatsReporterFromCactus to report histograms.
+type HistogramFormat int
+
+const (
+	// HistogramFormatHistogram reports histograms with the "h" type, as in "name:1|h".
+	// This is the default.
+	HistogramFormatHistogram HistogramFormat = iota
+
+	// HistogramFormatDistribution reports histograms with DogStatsD's "d" type, as in "name:1|d",
+	// which are aggregated globally rather than per agent.
+	HistogramFormatDistribution
+)
+
+// CactusOption configures a StatsReporter created by NewStatsReporterFromCactus.
+type CactusOption func(*barkCactusStatsReporter)
+
+// WithTagFormat encodes tags into the emitted metrics using the given format.
+func WithTagFormat(format TagFormat) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.tagFormat = format
+	}
+}
+
+// WithSampleRate sets the default sample rate, between 0.0 and 1.0, for metrics of the given kind.
+// Without it, every metric is reported.
+func WithSampleRate(kind MetricKind, rate float32) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.defaultRates[kind] = rate
+	}
+}
+
+// WithSampleRateForPrefix sets the sample rate of metrics of any kind whose names start with prefix,
+// overriding the default rate for their kind.
+//
+// Overrides are checked in the order they are passed to NewStatsReporterFromCactus,
+// and the first one matching a metric's name wins.
+func WithSampleRateForPrefix(prefix string, rate float32) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.overrides = append(s.overrides, sampleRateOverride{
+			match: func(name string) bool { return strings.HasPrefix(name, prefix) },
+			rate:  rate,
+		})
+	}
+}
+
+// WithSampleRateForPattern sets the sample rate of metrics of any kind whose names match pattern,
+// using the syntax of path.Match (for example "rpc.*.latency"), overriding the default rate for their kind.
+// Malformed patterns never match.
+//
+// Overrides are checked in the order they are passed to NewStatsReporterFromCactus,
+// and the first one matching a metric's name wins.
+func WithSampleRateForPattern(pattern string, rate float32) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.overrides = append(s.overrides, sampleRateOverride{
+			match: func(name string) bool {
+				matched, err := path.Match(pattern, name)
+				return err == nil && matched
+			},
+			rate: rate,
+		})
+	}
+}
+
+// WithSampler replaces the function deciding whether a metric is reported at a sample rate.
+// By default this is statsd.DefaultSampler.
+func WithSampler(sampler statsd.SamplerFunc) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.sampler = sampler
+	}
+}
+
+// WithHistogramFormat reports histograms using the given statsd metric type.
+func WithHistogramFormat(format HistogramFormat) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.histogramFormat = format
+	}
+}
+
+// WithHistogramBuckets buckets histogram values client-side, for statsd servers without
+// native histograms. Rather than sending each value, RecordHistogram then increments a
+// counter with the histogram's name, tagged with "le" set to the upper bound of the first
+// bucket the value fits in (or "+Inf"). Bounds are sorted, so they may be given in any order.
+//
+// Since buckets are distinguished by tags, this should be combined with WithTagFormat.
+func WithHistogramBuckets(bounds ...float64) CactusOption {
+	return func(s *barkCactusStatsReporter) {
+		s.buckets = append([]float64(nil), bounds...)
+		sort.Float64s(s.buckets)
+	}
+}
+
+type sampleRateOverride struct {
+	match func(name string) bool
+	rate  float32
+}
+
 type barkCactusStatsReporter struct {
-	delegate statsd.Statter
+	delegate  statsd.Statter
+	tagFormat TagFormat
+
+	defaultRates map[MetricKind]float32
+	overrides    []sampleRateOverride
+	sampler      statsd.SamplerFunc
+
+	histogramFormat HistogramFormat
+	buckets         []float64
 }
 
-func newBarkCactusStatsReporter(statter statsd.Statter) StatsReporter {
-	return &barkCactusStatsReporter{delegate: statter}
+func newBarkCactusStatsReporter(statter statsd.Statter, opts ...CactusOption) StatsReporter {
+	s := &barkCactusStatsReporter{
+		delegate:     statter,
+		defaultRates: make(map[MetricKind]float32),
+		sampler:      statsd.DefaultSampler,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *barkCactusStatsReporter) IncCounter(name string, tags Tags, value int64) {
-	s.delegate.Inc(name, value, 1.0)
+	rate := s.sampleRate(CounterMetric, name)
+	if !s.sampler(rate) {
+		return
+	}
+
+	name, suffix := s.encodeTags(name, tags)
+	if suffix == "" && rate >= 1 {
+		s.delegate.Inc(name, value, 1.0)
+		return
+	}
+	s.raw(name, strconv.FormatInt(value, 10)+"|c", rate, suffix)
 }
 
 func (s *barkCactusStatsReporter) UpdateGauge(name string, tags Tags, value int64) {
-	s.delegate.Gauge(name, value, 1.0)
+	rate := s.sampleRate(GaugeMetric, name)
+	if !s.sampler(rate) {
+		return
+	}
+
+	name, suffix := s.encodeTags(name, tags)
+	if suffix == "" && rate >= 1 {
+		s.delegate.Gauge(name, value, 1.0)
+		return
+	}
+	s.raw(name, strconv.FormatInt(value, 10)+"|g", rate, suffix)
 }
 
 func (s *barkCactusStatsReporter) RecordTimer(name string, tags Tags, d time.Duration) {
-	s.delegate.TimingDuration(name, d, 1.0)
+	rate := s.sampleRate(TimerMetric, name)
+	if !s.sampler(rate) {
+		return
+	}
+
+	name, suffix := s.encodeTags(name, tags)
+	if suffix == "" && rate >= 1 {
+		s.delegate.TimingDuration(name, d, 1.0)
+		return
+	}
+	// Timers are reported in milliseconds, as statsd.Statter.TimingDuration does
+	ms := float64(d) / float64(time.Millisecond)
+	s.raw(name, strconv.FormatFloat(ms, 'f', -1, 64)+"|ms", rate, suffix)
+}
+
+func (s *barkCactusStatsReporter) RecordHistogram(name string, tags Tags, value float64) {
+	rate := s.sampleRate(HistogramMetric, name)
+	if !s.sampler(rate) {
+		return
+	}
+
+	if len(s.buckets) > 0 {
+		name, suffix := s.encodeTags(name, withTag(tags, "le", s.bucket(value)))
+		if suffix == "" && rate >= 1 {
+			s.delegate.Inc(name, 1, 1.0)
+			return
+		}
+		s.raw(name, "1|c", rate, suffix)
+		return
+	}
+
+	typ := "|h"
+	if s.histogramFormat == HistogramFormatDistribution {
+		typ = "|d"
+	}
+	name, suffix := s.encodeTags(name, tags)
+	s.raw(name, strconv.FormatFloat(value, 'f', -1, 64)+typ, rate, suffix)
+}
+
+// bucket returns the formatted upper bound of the bucket a histogram value falls in
+func (s *barkCactusStatsReporter) bucket(value float64) string {
+	i := sort.SearchFloat64s(s.buckets, value)
+	if i == len(s.buckets) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(s.buckets[i], 'f', -1, 64)
+}
+
+// sampleRate returns the rate to sample the named metric at
+func (s *barkCactusStatsReporter) sampleRate(kind MetricKind, name string) float32 {
+	for _, o := range s.overrides {
+		if o.match(name) {
+			return o.rate
+		}
+	}
+
+	if rate, ok := s.defaultRates[kind]; ok {
+		return rate
+	}
+	return 1.0
+}
+
+// raw sends a metric already formatted as "value|type", appending the sample rate and tag suffix.
+// The metric has already been sampled, so it is sent with a rate of 1.0 to stop the statter
+// from sampling it again; the rate in the value still lets the server scale it.
+func (s *barkCactusStatsReporter) raw(name, value string, rate float32, suffix string) {
+	if rate < 1 {
+		value += "|@" + strconv.FormatFloat(float64(rate), 'f', -1, 32)
+	}
+	s.delegate.Raw(name, value+suffix, 1.0)
+}
+
+// encodeTags returns the name to emit a metric under and, for formats that tag
+// the value rather than the name, the suffix to append to its value.
+func (s *barkCactusStatsReporter) encodeTags(name string, tags Tags) (string, string) {
+	if len(tags) == 0 {
+		return name, ""
+	}
+
+	var b strings.Builder
+	switch s.tagFormat {
+	case TagFormatDogStatsD:
+		b.WriteString("|#")
+		for i, k := range sortedKeys(tags) {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(dogStatsDKeyReplacer.Replace(k))
+			b.WriteByte(':')
+			b.WriteString(dogStatsDValueReplacer.Replace(tags[k]))
+		}
+		return name, b.String()
+	case TagFormatInfluxDB:
+		b.WriteString(name)
+		for _, k := range sortedKeys(tags) {
+			b.WriteByte(',')
+			b.WriteString(influxDBReplacer.Replace(k))
+			b.WriteByte('=')
+			b.WriteString(influxDBReplacer.Replace(tags[k]))
+		}
+		return b.String(), ""
+	case TagFormatGraphite:
+		b.WriteString(name)
+		for _, k := range sortedKeys(tags) {
+			b.WriteByte('.')
+			b.WriteString(graphiteReplacer.Replace(k))
+			b.WriteByte('.')
+			b.WriteString(graphiteReplacer.Replace(tags[k]))
+		}
+		return b.String(), ""
+	}
+
+	return name, ""
+}
+
+var (
+	dogStatsDValueReplacer = strings.NewReplacer(",", "_", "|", "_", "#", "_", "\n", "_")
+	dogStatsDKeyReplacer   = strings.NewReplacer(",", "_", "|", "_", "#", "_", ":", "_", "\n", "_")
+	influxDBReplacer       = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `, ":", "_", "|", "_", "@", "_", "\n", "_")
+	graphiteReplacer       = strings.NewReplacer(".", "_", " ", "_", ":", "_", "|", "_", "@", "_", "\n", "_")
+)
+
+// withTag returns a copy of tags with the given tag added
+func withTag(tags Tags, key, value string) Tags {
+	merged := make(Tags, len(tags)+1)
+	for k, v := range tags {
+		merged[k] = v
+	}
+	merged[key] = value
+	return merged
+}
+
+func sortedKeys(tags Tags) []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
@@ -22,6 +22,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/uber-common/bark"
 	"github.com/uber-common/bark/mocks"
 )
@@ -44,3 +47,209 @@ func TestBarkCactusStatsReporter(t *testing.T) {
 	statter.AssertCalled(t, "TimingDuration", "bar", time.Duration(10), float32(1.0))
 	statter.AssertCalled(t, "Gauge", "baz", int64(123), float32(1.0))
 }
+
+func TestBarkCactusStatsReporterTags(t *testing.T) {
+	tags := bark.Tags{"zone": "us-east", "host": "web:1"}
+
+	tests := []struct {
+		format  bark.TagFormat
+		counter []interface{}
+		gauge   []interface{}
+		timer   []interface{}
+	}{
+		{
+			format:  bark.TagFormatNone,
+			counter: []interface{}{"Inc", "foo", int64(7), float32(1.0)},
+			gauge:   []interface{}{"Gauge", "baz", int64(-3), float32(1.0)},
+			timer:   []interface{}{"TimingDuration", "bar", 1500 * time.Microsecond, float32(1.0)},
+		},
+		{
+			format:  bark.TagFormatDogStatsD,
+			counter: []interface{}{"Raw", "foo", "7|c|#host:web:1,zone:us-east", float32(1.0)},
+			gauge:   []interface{}{"Raw", "baz", "-3|g|#host:web:1,zone:us-east", float32(1.0)},
+			timer:   []interface{}{"Raw", "bar", "1.5|ms|#host:web:1,zone:us-east", float32(1.0)},
+		},
+		{
+			format:  bark.TagFormatInfluxDB,
+			counter: []interface{}{"Inc", "foo,host=web_1,zone=us-east", int64(7), float32(1.0)},
+			gauge:   []interface{}{"Gauge", "baz,host=web_1,zone=us-east", int64(-3), float32(1.0)},
+			timer:   []interface{}{"TimingDuration", "bar,host=web_1,zone=us-east", 1500 * time.Microsecond, float32(1.0)},
+		},
+		{
+			format:  bark.TagFormatGraphite,
+			counter: []interface{}{"Inc", "foo.host.web_1.zone.us-east", int64(7), float32(1.0)},
+			gauge:   []interface{}{"Gauge", "baz.host.web_1.zone.us-east", int64(-3), float32(1.0)},
+			timer:   []interface{}{"TimingDuration", "bar.host.web_1.zone.us-east", 1500 * time.Microsecond, float32(1.0)},
+		},
+	}
+
+	for _, tt := range tests {
+		statter := &mocks.Statter{}
+		statter.On(tt.counter[0].(string), tt.counter[1:]...).Return(nil).Once()
+		statter.On(tt.gauge[0].(string), tt.gauge[1:]...).Return(nil).Once()
+		statter.On(tt.timer[0].(string), tt.timer[1:]...).Return(nil).Once()
+
+		barkClient := bark.NewStatsReporterFromCactus(statter, bark.WithTagFormat(tt.format))
+		barkClient.IncCounter("foo", tags, 7)
+		barkClient.UpdateGauge("baz", tags, -3)
+		barkClient.RecordTimer("bar", tags, 1500*time.Microsecond)
+
+		statter.AssertExpectations(t)
+	}
+}
+
+func TestBarkCactusStatsReporterTagEscaping(t *testing.T) {
+	tags := bark.Tags{"a,b": "c|d#e", "k=k": "v v", "dot.ted": "x.y@z"}
+
+	tests := []struct {
+		format bark.TagFormat
+		name   string
+		value  string
+	}{
+		{bark.TagFormatDogStatsD, "foo", "1|c|#a_b:c_d_e,dot.ted:x.y@z,k=k:v v"},
+		{bark.TagFormatInfluxDB, `foo,a\,b=c_d#e,dot.ted=x.y_z,k\=k=v\ v`, ""},
+		{bark.TagFormatGraphite, "foo.a,b.c_d#e.dot_ted.x_y_z.k=k.v_v", ""},
+	}
+
+	for _, tt := range tests {
+		statter := &mocks.Statter{}
+		if tt.value != "" {
+			statter.On("Raw", tt.name, tt.value, float32(1.0)).Return(nil).Once()
+		} else {
+			statter.On("Inc", tt.name, int64(1), float32(1.0)).Return(nil).Once()
+		}
+
+		bark.NewStatsReporterFromCactus(statter, bark.WithTagFormat(tt.format)).IncCounter("foo", tags, 1)
+		statter.AssertExpectations(t)
+	}
+}
+
+func TestBarkCactusStatsReporterNoTags(t *testing.T) {
+	for _, format := range []bark.TagFormat{bark.TagFormatDogStatsD, bark.TagFormatInfluxDB, bark.TagFormatGraphite} {
+		statter := &mocks.Statter{}
+		statter.On("Inc", "foo", int64(1), float32(1.0)).Return(nil).Twice()
+
+		barkClient := bark.NewStatsReporterFromCactus(statter, bark.WithTagFormat(format))
+		barkClient.IncCounter("foo", nil, 1)
+		barkClient.IncCounter("foo", bark.Tags{}, 1)
+		statter.AssertExpectations(t)
+	}
+}
+
+func TestBarkCactusStatsReporterSampleRates(t *testing.T) {
+	var rates []float32
+	sampleAll := func(rate float32) bool {
+		rates = append(rates, rate)
+		return true
+	}
+
+	statter := &mocks.Statter{}
+	barkClient := bark.NewStatsReporterFromCactus(statter,
+		bark.WithSampler(sampleAll),
+		bark.WithSampleRate(bark.CounterMetric, 0.5),
+		bark.WithSampleRate(bark.TimerMetric, 0.1),
+		bark.WithSampleRateForPrefix("rpc.", 0.25),
+		bark.WithSampleRateForPattern("*.critical", 1.0),
+		bark.WithSampleRateForPrefix("rpc.calls", 0.75), // shadowed by the earlier prefix
+	)
+
+	statter.On("Raw", "foo", "7|c|@0.5", float32(1.0)).Return(nil).Once()
+	statter.On("Gauge", "baz", int64(123), float32(1.0)).Return(nil).Once()
+	statter.On("Raw", "bar", "10|ms|@0.1", float32(1.0)).Return(nil).Once()
+	statter.On("Raw", "rpc.calls", "1|c|@0.25", float32(1.0)).Return(nil).Once()
+	statter.On("Raw", "rpc.latency", "2.5|ms|@0.25", float32(1.0)).Return(nil).Once()
+	statter.On("Inc", "jobs.critical", int64(3), float32(1.0)).Return(nil).Once()
+
+	barkClient.IncCounter("foo", nil, 7)
+	barkClient.UpdateGauge("baz", nil, 123)
+	barkClient.RecordTimer("bar", nil, 10*time.Millisecond)
+	barkClient.IncCounter("rpc.calls", nil, 1)
+	barkClient.RecordTimer("rpc.latency", nil, 2500*time.Microsecond)
+	barkClient.IncCounter("jobs.critical", nil, 3)
+
+	statter.AssertExpectations(t)
+	assert.Equal(t, []float32{0.5, 1.0, 0.1, 0.25, 0.25, 1.0}, rates)
+}
+
+func TestBarkCactusStatsReporterSampling(t *testing.T) {
+	sampled := false
+	statter := &mocks.Statter{}
+	barkClient := bark.NewStatsReporterFromCactus(statter,
+		bark.WithSampler(func(float32) bool { return sampled }),
+		bark.WithSampleRate(bark.CounterMetric, 0.5),
+		bark.WithTagFormat(bark.TagFormatDogStatsD),
+	)
+
+	// Dropped metrics never reach the statter
+	barkClient.IncCounter("foo", bark.Tags{"tag": "val"}, 7)
+	statter.AssertNotCalled(t, "Raw", mock.Anything, mock.Anything, mock.Anything)
+
+	// Kept metrics carry their rate ahead of their tags
+	sampled = true
+	statter.On("Raw", "foo", "7|c|@0.5|#tag:val", float32(1.0)).Return(nil).Once()
+	barkClient.IncCounter("foo", bark.Tags{"tag": "val"}, 7)
+	statter.AssertExpectations(t)
+}
+
+func TestBarkCactusStatsReporterZeroSampleRate(t *testing.T) {
+	statter := &mocks.Statter{}
+	barkClient := bark.NewStatsReporterFromCactus(statter, bark.WithSampleRateForPattern("debug.*", 0))
+
+	statter.On("Inc", "foo", int64(1), float32(1.0)).Return(nil).Once()
+	for i := 0; i < 100; i++ {
+		barkClient.IncCounter("debug.foo", nil, 1)
+	}
+	barkClient.IncCounter("foo", nil, 1)
+	statter.AssertExpectations(t)
+}
+
+func TestBarkCactusStatsReporterHistogram(t *testing.T) {
+	tests := []struct {
+		desc  string
+		opts  []bark.CactusOption
+		value string
+	}{
+		{"histogram", nil, "12.5|h"},
+		{"distribution", []bark.CactusOption{bark.WithHistogramFormat(bark.HistogramFormatDistribution)}, "12.5|d"},
+		{"tagged", []bark.CactusOption{bark.WithTagFormat(bark.TagFormatDogStatsD)}, "12.5|h|#tag:val"},
+		{"sampled", []bark.CactusOption{
+			bark.WithSampler(func(float32) bool { return true }),
+			bark.WithSampleRate(bark.HistogramMetric, 0.5),
+		}, "12.5|h|@0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			statter := &mocks.Statter{}
+			statter.On("Raw", "size", tt.value, float32(1.0)).Return(nil).Once()
+
+			barkClient := bark.NewStatsReporterFromCactus(statter, tt.opts...)
+			histograms, ok := barkClient.(bark.HistogramReporter)
+			require.True(t, ok, "Cactus reporter should implement HistogramReporter")
+
+			histograms.RecordHistogram("size", bark.Tags{"tag": "val"}, 12.5)
+			statter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBarkCactusStatsReporterHistogramBuckets(t *testing.T) {
+	statter := &mocks.Statter{}
+	barkClient := bark.NewStatsReporterFromCactus(statter,
+		bark.WithTagFormat(bark.TagFormatInfluxDB),
+		bark.WithHistogramBuckets(100, 10, 1000),
+	).(bark.HistogramReporter)
+
+	statter.On("Inc", "size,le=10,tag=val", int64(1), float32(1.0)).Return(nil).Twice()
+	statter.On("Inc", "size,le=100,tag=val", int64(1), float32(1.0)).Return(nil).Once()
+	statter.On("Inc", "size,le=+Inf,tag=val", int64(1), float32(1.0)).Return(nil).Once()
+
+	tags := bark.Tags{"tag": "val"}
+	barkClient.RecordHistogram("size", tags, 3)
+	barkClient.RecordHistogram("size", tags, 10)
+	barkClient.RecordHistogram("size", tags, 10.5)
+	barkClient.RecordHistogram("size", tags, 5000)
+
+	statter.AssertExpectations(t)
+	assert.Equal(t, bark.Tags{"tag": "val"}, tags, "Caller's tags should not be modified")
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+// Asserts that entries written by every method of a decorated observer are attributed to this file
+func assertSkipsCaller(t *testing.T, decorate func(bark.Logger) bark.Logger) {
+	methods := map[string]func(bark.Logger){
+		"Debug":  func(l bark.Logger) { l.Debug("hello") },
+		"Debugf": func(l bark.Logger) { l.Debugf("hello %s", "world") },
+		"Info":   func(l bark.Logger) { l.Info("hello") },
+		"Infof":  func(l bark.Logger) { l.Infof("hello %s", "world") },
+		"Warn":   func(l bark.Logger) { l.Warn("hello") },
+		"Warnf":  func(l bark.Logger) { l.Warnf("hello %s", "world") },
+		"Error":  func(l bark.Logger) { l.Error("hello") },
+		"Errorf": func(l bark.Logger) { l.Errorf("hello %s", "world") },
+		"Fatal":  func(l bark.Logger) { l.Fatal("hello") },
+		"Fatalf": func(l bark.Logger) { l.Fatalf("hello %s", "world") },
+		"Panic":  func(l bark.Logger) { assert.Panics(t, func() { l.Panic("hello") }) },
+		"Panicf": func(l bark.Logger) { assert.Panics(t, func() { l.Panicf("hello %s", "world") }) },
+	}
+
+	for method, log := range methods {
+		observer, logs := barktest.New(bark.DebugLevel, bark.WithExitFunc(func(int) {}))
+		log(decorate(observer))
+
+		entries := logs.All()
+		if assert.Equal(t, 1, len(entries), "Expected one entry from %s", method) {
+			assert.Equal(t, "caller_test.go", filepath.Base(entries[0].Caller.File),
+				"Expected %s entries to be attributed to the caller", method)
+		}
+	}
+}
+
+// Asserts that a logger's AddCallerSkip skips the frame of a helper function
+func assertAddCallerSkip(t *testing.T, logger bark.Logger, logs *barktest.ObservedLogs) {
+	helper := func(msg string) {
+		logger.(bark.CallerSkipper).AddCallerSkip(1).Info(msg)
+	}
+	helper("hello")
+
+	entries := logs.All()
+	if assert.Equal(t, 1, len(entries)) {
+		assert.Equal(t, "github.com/uber-common/bark_test.assertAddCallerSkip", entries[0].Caller.Function,
+			"Expected the helper's frame to be skipped")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type contextKey struct{}
+
+// ContextExtractor returns fields to add to loggers retrieved from a context with FromContext,
+// such as a request ID stored in the context. It may return nil to add no fields.
+type ContextExtractor func(ctx context.Context) Fields
+
+type registeredExtractor struct {
+	id      int
+	extract ContextExtractor
+}
+
+var (
+	extractorsMu    sync.RWMutex
+	extractors      []registeredExtractor
+	nextExtractorID int
+
+	nopLogger = NewNopLogger()
+)
+
+// NewContext returns a copy of ctx carrying logger, to be retrieved with FromContext.
+func NewContext(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, or a no-op logger if there isn't one,
+// with the fields returned by every registered ContextExtractor added.
+// Fields from later extractors replace those from earlier ones.
+func FromContext(ctx context.Context) Logger {
+	logger, ok := ctx.Value(contextKey{}).(Logger)
+	if !ok {
+		return nopLogger
+	}
+
+	extractorsMu.RLock()
+	defer extractorsMu.RUnlock()
+
+	var fields Fields
+	for _, e := range extractors {
+		for k, v := range e.extract(ctx) {
+			if fields == nil {
+				fields = make(Fields)
+			}
+			fields[k] = v
+		}
+	}
+
+	if fields == nil {
+		return logger
+	}
+	return logger.WithFields(fields)
+}
+
+// RegisterContextExtractor registers an extractor to be applied by FromContext, typically
+// while the program initializes. It returns a function that unregisters the extractor.
+func RegisterContextExtractor(extractor ContextExtractor) (unregister func()) {
+	extractorsMu.Lock()
+	defer extractorsMu.Unlock()
+
+	id := nextExtractorID
+	nextExtractorID++
+	extractors = append(extractors, registeredExtractor{id: id, extract: extractor})
+
+	return func() {
+		extractorsMu.Lock()
+		defer extractorsMu.Unlock()
+
+		for i, e := range extractors {
+			if e.id == id {
+				// Copy rather than modifying in place, to leave the backing array alone
+				extractors = append(extractors[:i:i], extractors[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// ContextValueExtractor returns a ContextExtractor that adds the value stored in a context
+// under key, if there is one, as the named field.
+func ContextValueExtractor(key interface{}, field string) ContextExtractor {
+	return func(ctx context.Context) Fields {
+		if v := ctx.Value(key); v != nil {
+			return Fields{field: v}
+		}
+		return nil
+	}
+}
+
+// DeadlineExtractor returns a ContextExtractor that adds the time remaining before a context's
+// deadline, if it has one, as the named time.Duration field.
+func DeadlineExtractor(field string) ContextExtractor {
+	return func(ctx context.Context) Fields {
+		if deadline, ok := ctx.Deadline(); ok {
+			return Fields{field: time.Until(deadline)}
+		}
+		return nil
+	}
+}
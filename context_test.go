@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+type requestIDKey struct{}
+
+func TestFromContext(t *testing.T) {
+	logger, _ := getBarkLogger()
+	logger = logger.WithField("service", "test")
+
+	ctx := bark.NewContext(context.Background(), logger)
+	assert.Equal(t, logger, bark.FromContext(ctx), "Expected the logger carried by the context")
+	assert.Equal(t, bark.Fields{"service": "test"}, bark.FromContext(ctx).Fields())
+}
+
+func TestFromContextMissing(t *testing.T) {
+	logger := bark.FromContext(context.Background())
+	require.NotNil(t, logger, "Expected a no-op logger")
+	assert.NotPanics(t, func() { logger.Info("hello") })
+}
+
+func TestContextExtractors(t *testing.T) {
+	defer bark.RegisterContextExtractor(bark.ContextValueExtractor(requestIDKey{}, "request_id"))()
+	defer bark.RegisterContextExtractor(func(ctx context.Context) bark.Fields {
+		return bark.Fields{"tenant": "acme"}
+	})()
+
+	logger, logs := barktest.New(bark.DebugLevel)
+	ctx := bark.NewContext(context.Background(), logger)
+
+	// Extractors returning nothing add nothing
+	bark.FromContext(ctx).Info("no request")
+
+	ctx = context.WithValue(ctx, requestIDKey{}, "abc123")
+	bark.FromContext(ctx).Info("request")
+
+	entries := logs.TakeAll()
+	require.Len(t, entries, 2)
+	assert.Equal(t, bark.Fields{"tenant": "acme"}, entries[0].Fields)
+	assert.Equal(t, bark.Fields{"tenant": "acme", "request_id": "abc123"}, entries[1].Fields)
+}
+
+func TestContextExtractorOrder(t *testing.T) {
+	defer bark.RegisterContextExtractor(func(context.Context) bark.Fields { return bark.Fields{"foo": "first"} })()
+	unregister := bark.RegisterContextExtractor(func(context.Context) bark.Fields { return bark.Fields{"foo": "second"} })
+
+	logger, _ := getBarkLogger()
+	ctx := bark.NewContext(context.Background(), logger)
+	assert.Equal(t, bark.Fields{"foo": "second"}, bark.FromContext(ctx).Fields(), "Later extractors should win")
+
+	unregister()
+	unregister() // no-op
+	assert.Equal(t, bark.Fields{"foo": "first"}, bark.FromContext(ctx).Fields(), "Unregistered extractors should not run")
+}
+
+func TestDeadlineExtractor(t *testing.T) {
+	defer bark.RegisterContextExtractor(bark.DeadlineExtractor("deadline_remaining"))()
+
+	logger, _ := getBarkLogger()
+	ctx := bark.NewContext(context.Background(), logger)
+	assert.Nil(t, bark.FromContext(ctx).Fields(), "Expected no fields without a deadline")
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	remaining, ok := bark.FromContext(ctx).Fields()["deadline_remaining"].(time.Duration)
+	require.True(t, ok, "Expected the remaining time as a duration")
+	assert.True(t, remaining > 0 && remaining <= time.Minute, "Unexpected remaining time %v", remaining)
+}
@@ -0,0 +1,411 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	// DedupeKey is the field holding the key of suppressed entries in summary lines.
+	DedupeKey = "dedupe_key"
+	// SuppressedKey is the field holding the number of suppressed entries in summary lines.
+	SuppressedKey = "suppressed"
+
+	_defaultDedupeKeys = 4096
+)
+
+// DedupingLogger is a Logger that can suppress repeated entries sharing a caller-chosen key.
+// Its own logging methods are not deduplicated; use Once or Every to log under a key.
+type DedupingLogger interface {
+	Logger
+
+	// Once returns a Logger that logs only the first entry for key.
+	Once(key string) Logger
+
+	// Every returns a Logger that logs at most one entry for key per interval.
+	Every(key string, interval time.Duration) Logger
+
+	// Sync logs summaries for keys whose windows have closed, then syncs the underlying logger.
+	Sync() error
+
+	// Close stops the timers that summarize windows as they close, then logs summaries for every
+	// key with suppressed entries. Entries may still be logged afterwards, but are summarized only
+	// on Sync and eviction.
+	Close() error
+}
+
+// NewDedupingLogger wraps a logger to suppress repeated entries by key. Keys are held in a
+// least-recently-used cache of maxKeys entries (or 4096 if maxKeys is not positive), so memory
+// use is bounded; a key that is evicted is forgotten, and its next entry is logged again.
+//
+// When entries have been suppressed, a summary line such as "suppressed 532 similar messages"
+// is logged at the level of the last suppressed entry, with its fields plus DedupeKey and
+// SuppressedKey. Summaries are logged when the key's window closes, from a timer goroutine, and
+// before then if the key is evicted; keys logged with Once are summarized only on eviction and Close.
+// Sync also logs summaries for closed windows that the timers haven't logged yet. Panic and Fatal
+// entries are never suppressed.
+// If logger implements CallerSkipper, so do the loggers returned by Once and Every, and entries are
+// attributed to their callers.
+func NewDedupingLogger(logger Logger, maxKeys int) DedupingLogger {
+	if maxKeys <= 0 {
+		maxKeys = _defaultDedupeKeys
+	}
+	d := &deduper{
+		maxKeys: maxKeys,
+		lru:     list.New(),
+		keys:    make(map[string]*list.Element),
+	}
+	return &dedupingLogger{Logger: logger, deduper: d}
+}
+
+type dedupeState struct {
+	key        string
+	until      time.Time // zero for keys logged only once
+	suppressed int
+	level      Level
+	logger     Logger      // the last suppressed entry's logger, used for the summary
+	timer      *time.Timer // summarizes the window when it closes, if entries were suppressed
+}
+
+// summary logs the number of suppressed entries, if any, and resets the count
+func (s *dedupeState) summary() func() {
+	if s.suppressed == 0 {
+		return nil
+	}
+	logger, level, key, n := s.logger, s.level, s.key, s.suppressed
+	s.suppressed, s.logger = 0, nil
+	return func() {
+		logf(logger.WithFields(Fields{DedupeKey: key, SuppressedKey: n}), level,
+			"suppressed %d similar messages", n)
+	}
+}
+
+type deduper struct {
+	sync.Mutex
+
+	maxKeys int
+	lru     *list.List
+	keys    map[string]*list.Element
+	stopped bool // set by Close, after which no timers are started
+}
+
+// allow records an entry for key, reporting whether it should be logged. Summaries of entries
+// suppressed earlier are returned for logging once the lock has been released.
+func (d *deduper) allow(key string, interval time.Duration, level Level, logger Logger) (bool, []func()) {
+	d.Lock()
+	defer d.Unlock()
+
+	now := time.Now()
+	var until time.Time
+	if interval > 0 {
+		until = now.Add(interval)
+	}
+
+	if el, ok := d.keys[key]; ok {
+		d.lru.MoveToFront(el)
+		s := el.Value.(*dedupeState)
+		if s.until.IsZero() || now.Before(s.until) {
+			s.suppressed++
+			s.level, s.logger = level, logger
+			d.schedule(s, now)
+			return false, nil
+		}
+		s.until = until
+		return true, appendSummary(nil, s)
+	}
+
+	var summaries []func()
+	for d.lru.Len() >= d.maxKeys {
+		s := d.lru.Remove(d.lru.Back()).(*dedupeState)
+		delete(d.keys, s.key)
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+		summaries = appendSummary(summaries, s)
+	}
+	d.keys[key] = d.lru.PushFront(&dedupeState{key: key, until: until})
+	return true, summaries
+}
+
+// schedule starts a timer to summarize the state's window when it closes, unless one is running or
+// the window never closes; the caller must hold the lock
+func (d *deduper) schedule(s *dedupeState, now time.Time) {
+	if s.timer != nil || s.until.IsZero() || d.stopped {
+		return
+	}
+	s.timer = time.AfterFunc(s.until.Sub(now), func() {
+		d.expire(s)
+	})
+}
+
+// expire logs the summary for a state whose timer has fired, if its window has closed. If the window
+// was reopened in the meantime, another timer is started for the new window.
+func (d *deduper) expire(s *dedupeState) {
+	d.Lock()
+	s.timer = nil
+	if el, ok := d.keys[s.key]; !ok || el.Value != s {
+		d.Unlock()
+		return
+	}
+
+	now := time.Now()
+	var summary func()
+	if now.Before(s.until) {
+		if s.suppressed > 0 {
+			d.schedule(s, now)
+		}
+	} else {
+		summary = s.summary()
+	}
+	d.Unlock()
+
+	if summary != nil {
+		summary()
+	}
+}
+
+// stop stops every timer and returns summaries for every key with suppressed entries
+func (d *deduper) stop() []func() {
+	d.Lock()
+	defer d.Unlock()
+
+	d.stopped = true
+	var summaries []func()
+	for el := d.lru.Front(); el != nil; el = el.Next() {
+		s := el.Value.(*dedupeState)
+		if s.timer != nil {
+			s.timer.Stop()
+			s.timer = nil
+		}
+		summaries = appendSummary(summaries, s)
+	}
+	return summaries
+}
+
+// closed returns summaries for every key whose window has closed
+func (d *deduper) closed() []func() {
+	d.Lock()
+	defer d.Unlock()
+
+	now := time.Now()
+	var summaries []func()
+	for el := d.lru.Front(); el != nil; el = el.Next() {
+		if s := el.Value.(*dedupeState); !s.until.IsZero() && !now.Before(s.until) {
+			summaries = appendSummary(summaries, s)
+		}
+	}
+	return summaries
+}
+
+// sync logs summaries for closed windows, then syncs the logger
+func (d *deduper) sync(logger Logger) error {
+	for _, summary := range d.closed() {
+		summary()
+	}
+	return syncLogger(logger)
+}
+
+func appendSummary(summaries []func(), s *dedupeState) []func() {
+	if f := s.summary(); f != nil {
+		return append(summaries, f)
+	}
+	return summaries
+}
+
+func logf(logger Logger, level Level, format string, args ...interface{}) {
+	switch level {
+	case DebugLevel:
+		logger.Debugf(format, args...)
+	case InfoLevel:
+		logger.Infof(format, args...)
+	case WarnLevel:
+		logger.Warnf(format, args...)
+	default:
+		logger.Errorf(format, args...)
+	}
+}
+
+type dedupingLogger struct {
+	Logger
+
+	deduper *deduper
+}
+
+func (l *dedupingLogger) Once(key string) Logger {
+	return &keyedLogger{Logger: SkipCaller(l.Logger, 1), deduper: l.deduper, key: key}
+}
+
+func (l *dedupingLogger) Every(key string, interval time.Duration) Logger {
+	return &keyedLogger{Logger: SkipCaller(l.Logger, 1), deduper: l.deduper, key: key, interval: interval}
+}
+
+func (l *dedupingLogger) WithField(key string, value interface{}) Logger {
+	return &dedupingLogger{Logger: l.Logger.WithField(key, value), deduper: l.deduper}
+}
+
+func (l *dedupingLogger) WithFields(keyValues LogFields) Logger {
+	return &dedupingLogger{Logger: l.Logger.WithFields(keyValues), deduper: l.deduper}
+}
+
+func (l *dedupingLogger) WithError(err error) Logger {
+	return &dedupingLogger{Logger: l.Logger.WithError(err), deduper: l.deduper}
+}
+
+func (l *dedupingLogger) AddCallerSkip(skip int) Logger {
+	return &dedupingLogger{Logger: SkipCaller(l.Logger, skip), deduper: l.deduper}
+}
+
+func (l *dedupingLogger) Enabled(level Level) bool {
+	return enabled(l.Logger, level)
+}
+
+func (l *dedupingLogger) Level() Level {
+	return levelOf(l.Logger)
+}
+
+func (l *dedupingLogger) Sync() error {
+	return l.deduper.sync(l.Logger)
+}
+
+func (l *dedupingLogger) Close() error {
+	for _, summary := range l.deduper.stop() {
+		summary()
+	}
+	return syncLogger(l.Logger)
+}
+
+// keyedLogger deduplicates every Debug through Error entry under a single key
+type keyedLogger struct {
+	Logger
+
+	deduper  *deduper
+	key      string
+	interval time.Duration
+}
+
+func (l *keyedLogger) allow(level Level) bool {
+	if !l.Enabled(level) {
+		return false
+	}
+	ok, summaries := l.deduper.allow(l.key, l.interval, level, l.Logger)
+	for _, summary := range summaries {
+		summary()
+	}
+	return ok
+}
+
+func (l *keyedLogger) Debug(args ...interface{}) {
+	if l.allow(DebugLevel) {
+		l.Logger.Debug(args...)
+	}
+}
+
+func (l *keyedLogger) Debugf(format string, args ...interface{}) {
+	if l.allow(DebugLevel) {
+		l.Logger.Debugf(format, args...)
+	}
+}
+
+func (l *keyedLogger) Info(args ...interface{}) {
+	if l.allow(InfoLevel) {
+		l.Logger.Info(args...)
+	}
+}
+
+func (l *keyedLogger) Infof(format string, args ...interface{}) {
+	if l.allow(InfoLevel) {
+		l.Logger.Infof(format, args...)
+	}
+}
+
+func (l *keyedLogger) Warn(args ...interface{}) {
+	if l.allow(WarnLevel) {
+		l.Logger.Warn(args...)
+	}
+}
+
+func (l *keyedLogger) Warnf(format string, args ...interface{}) {
+	if l.allow(WarnLevel) {
+		l.Logger.Warnf(format, args...)
+	}
+}
+
+func (l *keyedLogger) Error(args ...interface{}) {
+	if l.allow(ErrorLevel) {
+		l.Logger.Error(args...)
+	}
+}
+
+func (l *keyedLogger) Errorf(format string, args ...interface{}) {
+	if l.allow(ErrorLevel) {
+		l.Logger.Errorf(format, args...)
+	}
+}
+
+func (l *keyedLogger) Panic(args ...interface{}) {
+	l.Logger.Panic(args...)
+}
+
+func (l *keyedLogger) Panicf(format string, args ...interface{}) {
+	l.Logger.Panicf(format, args...)
+}
+
+func (l *keyedLogger) Fatal(args ...interface{}) {
+	l.Logger.Fatal(args...)
+}
+
+func (l *keyedLogger) Fatalf(format string, args ...interface{}) {
+	l.Logger.Fatalf(format, args...)
+}
+
+func (l *keyedLogger) WithField(key string, value interface{}) Logger {
+	return l.derive(l.Logger.WithField(key, value))
+}
+
+func (l *keyedLogger) WithFields(keyValues LogFields) Logger {
+	return l.derive(l.Logger.WithFields(keyValues))
+}
+
+func (l *keyedLogger) WithError(err error) Logger {
+	return l.derive(l.Logger.WithError(err))
+}
+
+func (l *keyedLogger) derive(logger Logger) Logger {
+	return &keyedLogger{Logger: logger, deduper: l.deduper, key: l.key, interval: l.interval}
+}
+
+func (l *keyedLogger) AddCallerSkip(skip int) Logger {
+	return l.derive(SkipCaller(l.Logger, skip))
+}
+
+func (l *keyedLogger) Enabled(level Level) bool {
+	return enabled(l.Logger, level)
+}
+
+func (l *keyedLogger) Level() Level {
+	return levelOf(l.Logger)
+}
+
+// Sync logs summaries for every key whose window has closed, like DedupingLogger's Sync
+func (l *keyedLogger) Sync() error {
+	return l.deduper.sync(l.Logger)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+func TestDedupingLoggerOnce(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+
+	for i := 0; i < 5; i++ {
+		dedupe.Once("startup").Warnf("config missing, attempt %d", i)
+		dedupe.Info("not deduplicated")
+	}
+
+	assert.Equal(t, 1, logs.FilterMessageSnippet("config missing").Len())
+	assert.Equal(t, "config missing, attempt 0", logs.FilterMessageSnippet("config missing").All()[0].Message)
+	assert.Equal(t, 5, logs.FilterMessage("not deduplicated").Len())
+
+	require.NoError(t, dedupe.Sync())
+	assert.Equal(t, 0, logs.FilterMessageSnippet("suppressed").Len(),
+		"Once windows never close, so Sync should not summarize them")
+}
+
+func TestDedupingLoggerEvery(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+
+	for i := 0; i < 4; i++ {
+		dedupe.Every("conn", 30*time.Millisecond).WithField("attempt", i).Error("connection refused")
+	}
+	require.Equal(t, 1, logs.Len(), "Expected entries within the interval to be suppressed")
+
+	time.Sleep(50 * time.Millisecond)
+	dedupe.Every("conn", 30*time.Millisecond).Error("connection refused")
+
+	entries := logs.TakeAll()
+	require.Equal(t, 3, len(entries))
+	assert.Equal(t, "connection refused", entries[0].Message)
+
+	summary := entries[1]
+	assert.Equal(t, bark.ErrorLevel, summary.Level)
+	assert.Equal(t, "suppressed 3 similar messages", summary.Message)
+	assert.Equal(t, bark.Fields{"attempt": 3, bark.DedupeKey: "conn", bark.SuppressedKey: 3}, summary.Fields,
+		"Expected the summary to carry the last suppressed entry's fields")
+
+	assert.Equal(t, "connection refused", entries[2].Message)
+}
+
+func TestDedupingLoggerSyncSummarizesClosedWindows(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+
+	dedupe.Every("short", 10*time.Millisecond).Info("tick")
+	dedupe.Every("short", 10*time.Millisecond).Info("tick")
+	dedupe.Every("long", time.Hour).Info("tock")
+	dedupe.Every("long", time.Hour).Info("tock")
+
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, dedupe.Sync())
+	require.NoError(t, dedupe.Sync())
+
+	summaries := logs.FilterMessageSnippet("suppressed").All()
+	require.Equal(t, 1, len(summaries), "Expected only closed windows to be summarized, once")
+	assert.Equal(t, "short", summaries[0].Fields[bark.DedupeKey])
+	assert.Equal(t, bark.InfoLevel, summaries[0].Level)
+}
+
+func TestDedupingLoggerSummarizesWhenWindowsClose(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+	defer dedupe.Close()
+
+	for i := 0; i < 3; i++ {
+		dedupe.Every("conn", 10*time.Millisecond).Warn("connection refused")
+	}
+	assert.Eventually(t, func() bool {
+		return logs.FilterMessage("suppressed 2 similar messages").Len() == 1
+	}, time.Second, time.Millisecond, "Expected a summary once the window closed, without Sync")
+
+	require.NoError(t, dedupe.Every("conn", 10*time.Millisecond).(bark.Syncer).Sync())
+	assert.Equal(t, 1, logs.FilterMessageSnippet("suppressed").Len(), "Expected each window to be summarized once")
+}
+
+func TestDedupingLoggerKeyedSync(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+	require.NoError(t, dedupe.Close(), "Expected Close to stop the timers")
+
+	every := dedupe.Every("short", 10*time.Millisecond)
+	every.Info("tick")
+	every.Info("tick")
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 0, logs.FilterMessageSnippet("suppressed").Len(), "Expected no timers after Close")
+
+	require.NoError(t, every.(bark.Syncer).Sync())
+	assert.Equal(t, 1, logs.FilterMessage("suppressed 1 similar messages").Len(),
+		"Expected keyed loggers to summarize closed windows on Sync")
+}
+
+func TestDedupingLoggerClose(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+
+	dedupe.Once("startup").Info("config missing")
+	dedupe.Once("startup").Info("config missing")
+	dedupe.Every("conn", time.Hour).Warn("connection refused")
+	dedupe.Every("conn", time.Hour).Warn("connection refused")
+	dedupe.Every("conn", time.Hour).Warn("connection refused")
+	require.NoError(t, dedupe.Close())
+
+	summaries := logs.FilterMessageSnippet("suppressed")
+	assert.Equal(t, 2, summaries.Len(), "Expected Close to summarize every key")
+	assert.Equal(t, 1, summaries.FilterField(bark.DedupeKey, "startup").FilterField(bark.SuppressedKey, 1).Len())
+	assert.Equal(t, 1, summaries.FilterField(bark.DedupeKey, "conn").FilterField(bark.SuppressedKey, 2).Len())
+}
+
+func TestDedupingLoggerEviction(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 2)
+
+	dedupe.Once("a").Info("a")
+	dedupe.Once("a").Info("a")
+	dedupe.Once("b").Info("b")
+	dedupe.Once("a").Info("a") // a is now more recently used than b
+	dedupe.Once("c").Info("c") // evicts b, which has nothing to summarize
+	dedupe.Once("a").Info("a")
+	dedupe.Once("d").Info("d") // evicts c
+	dedupe.Once("b").Info("b") // evicts a, summarizing it
+
+	var messages []string
+	for _, e := range logs.All() {
+		messages = append(messages, e.Message)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "suppressed 3 similar messages", "b"}, messages)
+}
+
+func TestDedupingLoggerPassesThrough(t *testing.T) {
+	logger, logs := barktest.New(bark.InfoLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+	once := dedupe.Once("key")
+
+	once.Debug("disabled")
+	once.Debugf("disabled %d", 1)
+	once.Info("enabled")
+	assert.Equal(t, 1, logs.Len(), "Disabled entries should not use up the key")
+
+	for i := 0; i < 2; i++ {
+		assert.Panics(t, func() { once.Panic("oh no") })
+	}
+	assert.Equal(t, 2, logs.FilterLevel(bark.PanicLevel).Len(), "Panic entries should never be suppressed")
+
+	levels, ok := once.(bark.LevelEnabler)
+	require.True(t, ok, "Keyed loggers should implement LevelEnabler")
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.Equal(t, bark.InfoLevel, dedupe.(bark.LevelEnabler).Level())
+	assert.NoError(t, once.(bark.Syncer).Sync())
+}
+
+func TestDedupingLoggerDerivedLoggers(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	dedupe := bark.NewDedupingLogger(logger, 0)
+
+	child, ok := dedupe.WithField("foo", "bar").(bark.DedupingLogger)
+	require.True(t, ok, "Expected derived loggers to remain DedupingLoggers")
+	child.Once("key").Info("hello")
+	dedupe.Once("key").WithError(fmt.Errorf("oh no")).Info("hello")
+	dedupe.WithFields(bark.Fields{"baz": 1}).(bark.DedupingLogger).Once("key").Info("hello")
+
+	entries := logs.All()
+	require.Equal(t, 1, len(entries), "Expected derived loggers to share keys")
+	assert.Equal(t, bark.Fields{"foo": "bar"}, entries[0].Fields)
+}
+
+func TestDedupingLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return bark.NewDedupingLogger(logger, 0)
+	})
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return bark.NewDedupingLogger(logger, 0).Every("key", time.Nanosecond)
+	})
+}
+
+func TestDedupingLoggerSkipsCaller(t *testing.T) {
+	assertSkipsCaller(t, func(l bark.Logger) bark.Logger {
+		return bark.NewDedupingLogger(l, 0).Once("key")
+	})
+	assertSkipsCaller(t, func(l bark.Logger) bark.Logger {
+		deduped := bark.NewDedupingLogger(bark.NewSamplingLogger(l, time.Minute, 10, 0), 0)
+		return bark.NewNamedLogger(bark.NewRedactingLogger(deduped.Every("key", time.Minute)), nil)
+	})
+
+	observer, logs := barktest.New(bark.DebugLevel)
+	assertAddCallerSkip(t, bark.NewDedupingLogger(observer, 0).Once("key"), logs)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+// ExitOption configures what a Logger does after writing a Fatal entry.
+type ExitOption func(*exitConfig)
+
+// WithExitFunc replaces the function called to terminate the process after a Fatal entry,
+// so that Fatal can be tested in-process. The function is called with exit code 1; if it
+// returns, so does Fatal.
+func WithExitFunc(exit func(code int)) ExitOption {
+	return func(c *exitConfig) {
+		c.exit = exit
+	}
+}
+
+// WithPreExitHook adds a hook that runs after a Fatal entry is written and before the process
+// exits, as in flushing stats reporters or closing files. Hooks run in the order they're added.
+func WithPreExitHook(hook func()) ExitOption {
+	return func(c *exitConfig) {
+		c.hooks = append(c.hooks, hook)
+	}
+}
+
+// NewExitFunc returns a function that runs the pre-exit hooks set by opts, then terminates the
+// process with the function set by WithExitFunc, or with fallback if there is none. It lets
+// Logger implementations outside this package support ExitOptions.
+func NewExitFunc(fallback func(code int), opts ...ExitOption) func(code int) {
+	return newExitConfig(fallback, opts).run
+}
+
+type exitConfig struct {
+	exit  func(code int)
+	hooks []func()
+}
+
+func newExitConfig(fallback func(code int), opts []ExitOption) *exitConfig {
+	c := &exitConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.exit == nil {
+		c.exit = fallback
+	}
+	return c
+}
+
+func (c *exitConfig) run(code int) {
+	for _, hook := range c.hooks {
+		hook()
+	}
+	c.exit(code)
+}
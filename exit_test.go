@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+)
+
+func TestNewExitFunc(t *testing.T) {
+	var calls []string
+	fallback := func(code int) { calls = append(calls, "fallback") }
+	hook := func(name string) func() {
+		return func() { calls = append(calls, name) }
+	}
+
+	bark.NewExitFunc(fallback)(1)
+	assert.Equal(t, []string{"fallback"}, calls)
+
+	calls = nil
+	bark.NewExitFunc(fallback, bark.WithPreExitHook(hook("first")), bark.WithPreExitHook(hook("second")))(1)
+	assert.Equal(t, []string{"first", "second", "fallback"}, calls, "Expected hooks to run in order before exiting")
+
+	calls = nil
+	var code int
+	exit := bark.NewExitFunc(fallback,
+		bark.WithPreExitHook(hook("hook")),
+		bark.WithExitFunc(func(c int) { code = c }),
+	)
+	exit(2)
+	assert.Equal(t, []string{"hook"}, calls, "Expected WithExitFunc to replace the fallback")
+	assert.Equal(t, 2, code)
+}
@@ -85,6 +85,102 @@ type Logger interface {
 	Fields() Fields
 }
 
+// LevelEnabler is an optional interface implemented by Loggers that can report which
+// levels they actually write, so callers can skip building expensive arguments for
+// disabled levels:
+//
+//	if le, ok := logger.(bark.LevelEnabler); !ok || le.Enabled(bark.DebugLevel) {
+//		logger.Debugf("state: %v", expensiveDump())
+//	}
+type LevelEnabler interface {
+	// Report whether entries at the given level would be written
+	Enabled(level Level) bool
+
+	// Return the lowest level that would be written
+	Level() Level
+}
+
+// LevelSetter is an optional interface implemented by Loggers whose level can be changed at runtime,
+// so that a LevelRegistry can make them write the levels it enables.
+type LevelSetter interface {
+	// Set the lowest level that would be written
+	SetLevel(level Level)
+}
+
+// Syncer is an optional interface implemented by Loggers whose output may be buffered,
+// so that callers can flush buffered entries before the process exits.
+type Syncer interface {
+	// Flush any buffered log entries
+	Sync() error
+}
+
+// LevelLogger is an optional interface implemented by Loggers that can write an entry at any level.
+// Unlike Fatal and Panic, Log and Logf only write the entry: they never exit or panic, so loggers
+// that fan entries out to other loggers can take the terminal action themselves, exactly once.
+type LevelLogger interface {
+	// Log at the given level, without exiting or panicking
+	Log(level Level, args ...interface{})
+
+	// Log at the given level with fmt.Printf-like formatting, without exiting or panicking
+	Logf(level Level, format string, args ...interface{})
+}
+
+// NamedLogger is an optional interface implemented by Loggers that can be named, so that
+// entries record which component wrote them. Names nest: logger.Named("storage").Named("cache")
+// is named "storage.cache".
+type NamedLogger interface {
+	// Return a logger with the given name appended to this logger's name
+	Named(name string) Logger
+}
+
+// CallerSkipper is an optional interface implemented by Loggers that report the code that wrote
+// each entry. Adapters and wrappers that call a Logger on behalf of their own callers can skip
+// their stack frames, so that entries are attributed to the right code.
+type CallerSkipper interface {
+	// Return a logger that skips the given number of additional stack frames when reporting callers
+	AddCallerSkip(skip int) Logger
+}
+
+// SkipCaller returns a logger that skips the given number of additional stack frames, if the
+// logger implements CallerSkipper, and the logger itself otherwise. Wrappers that call a Logger
+// on behalf of their own callers use it to skip their frames.
+//
+// Wrappers that embed the skipping logger must define every logging method, including Panic and
+// Fatal, rather than promoting them from the embedded Logger: promoted methods add no stack frame,
+// so entries written through them would skip one frame too many.
+func SkipCaller(logger Logger, skip int) Logger {
+	if skipper, ok := logger.(CallerSkipper); ok && skip != 0 {
+		return skipper.AddCallerSkip(skip)
+	}
+	return logger
+}
+
+// enabled reports whether logger writes entries at the given level, as loggers that don't
+// implement LevelEnabler do for every level
+func enabled(logger Logger, level Level) bool {
+	if levels, ok := logger.(LevelEnabler); ok {
+		return levels.Enabled(level)
+	}
+	return true
+}
+
+// levelOf returns the lowest level written by logger, which is DebugLevel for loggers that don't
+// implement LevelEnabler
+func levelOf(logger Logger) Level {
+	if levels, ok := logger.(LevelEnabler); ok {
+		return levels.Level()
+	}
+	return DebugLevel
+}
+
+// syncLogger syncs logger, if it implements Syncer
+func syncLogger(logger Logger) error {
+	if s, ok := logger.(Syncer); ok {
+		return s.Sync()
+	}
+	return nil
+}
+
 // LogFields is an interface for dictionaries passed to Logger's WithFields logging method.
 // It exists to provide a layer of indirection so code already using other
 // "Fields" types can be changed to use bark.Logger instances without
@@ -112,8 +208,13 @@ func NewNopLogger() Logger {
 }
 
 // NewLoggerFromLogrus creates a bark-compliant wrapper for a logrus-brand logger.
-func NewLoggerFromLogrus(logger *logrus.Logger) Logger {
-	return newBarkLogrusLogger(logger)
+// By default, Fatal and Fatalf exit through the logrus logger, running its exit handlers;
+// use WithExitFunc and WithPreExitHook to change what happens after a Fatal entry.
+func NewLoggerFromLogrus(logger *logrus.Logger, opts ...ExitOption) Logger {
+	if len(opts) == 0 {
+		return newBarkLogrusLogger(logger, nil)
+	}
+	return newBarkLogrusLogger(logger, newExitConfig(logger.Exit, opts))
 }
 
 // Tags is an alias of map[string]string, a type for tags associated with a statistic
@@ -132,7 +233,17 @@ type StatsReporter interface {
 	RecordTimer(name string, tags Tags, d time.Duration)
 }
 
+// HistogramReporter is an optional interface implemented by StatsReporters that can record
+// distributions of values, such as payload sizes or queue depths.
+type HistogramReporter interface {
+	// Record a value in a statsd-like histogram with optional tags
+	RecordHistogram(name string, tags Tags, value float64)
+}
+
 // NewStatsReporterFromCactus creates a bark-compliant wrapper for a cactus-brand statsd Statter.
-func NewStatsReporterFromCactus(statter statsd.Statter) StatsReporter {
-	return newBarkCactusStatsReporter(statter)
+// By default tags are dropped and every metric is reported; use WithTagFormat to encode tags
+// into the emitted metrics, and WithSampleRate and friends to sample metrics client-side.
+// The returned StatsReporter also implements HistogramReporter.
+func NewStatsReporterFromCactus(statter statsd.Statter, opts ...CactusOption) StatsReporter {
+	return newBarkCactusStatsReporter(statter, opts...)
 }
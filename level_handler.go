@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type levelRequest struct {
+	Logger string `json:"logger"`
+	Level  *Level `json:"level"`
+	TTL    string `json:"ttl"`
+}
+
+type levelResponse struct {
+	Logger string `json:"logger,omitempty"`
+	Level  Level  `json:"level"`
+
+	// Loggers holds the levels set for prefixes other than the root
+	Loggers map[string]Level `json:"loggers,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP is a simple JSON endpoint for viewing and changing levels at runtime, modeled on
+// zap.AtomicLevel's handler.
+//
+// GET reports the root level and the levels set for other prefixes, or with a "logger" query
+// parameter, the level of that logger. Levels are reported as they take effect: levels below the
+// level written by the registry's backend are reported as the backend's level.
+//
+//	{"level":"info","loggers":{"storage":"debug"}}
+//	{"logger":"storage.cache","level":"debug"}
+//
+// PUT sets the level for a prefix, or the root level if the logger is omitted, and reports the
+// resulting level. Levels that the backend doesn't write and can't be made to write, because it
+// doesn't implement LevelSetter, are rejected. If a TTL is given, as a duration string such as "10m",
+// the previous setting is restored once it has elapsed, along with the backend's level:
+//
+//	{"logger":"storage","level":"debug","ttl":"10m"}
+func (r *LevelRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	enc := json.NewEncoder(w)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch req.Method {
+	case http.MethodGet:
+		if name := req.URL.Query().Get("logger"); name != "" {
+			enc.Encode(levelResponse{Logger: name, Level: r.effectiveLevel(r.Level(name))})
+			return
+		}
+
+		levels := r.Levels()
+		for prefix, level := range levels {
+			levels[prefix] = r.effectiveLevel(level)
+		}
+		enc.Encode(levelResponse{Level: r.effectiveLevel(r.Level("")), Loggers: levels})
+
+	case http.MethodPut:
+		var body levelRequest
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			enc.Encode(errorResponse{Error: fmt.Sprintf("Request body must be well-formed JSON: %v", err)})
+			return
+		}
+		if body.Level == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			enc.Encode(errorResponse{Error: "Must specify a logging level."})
+			return
+		}
+		if err := r.checkLevel(*body.Level); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			enc.Encode(errorResponse{Error: fmt.Sprintf("Can't set the level: %v.", err)})
+			return
+		}
+
+		if body.TTL == "" {
+			r.SetLevel(body.Logger, *body.Level)
+		} else {
+			ttl, err := time.ParseDuration(body.TTL)
+			if err != nil || ttl <= 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				enc.Encode(errorResponse{Error: fmt.Sprintf("TTL must be a positive duration, as in \"10m\": %q", body.TTL)})
+				return
+			}
+			r.SetLevelFor(body.Logger, *body.Level, ttl)
+		}
+
+		name := normalizePrefix(body.Logger)
+		enc.Encode(levelResponse{Logger: name, Level: r.effectiveLevel(r.Level(name))})
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		enc.Encode(errorResponse{Error: "Only GET and PUT are supported."})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+func doLevelRequest(t *testing.T, url, method, body string) (int, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+	out, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res.StatusCode, strings.TrimSpace(string(out))
+}
+
+func TestLevelRegistryHandler(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage", bark.DebugLevel)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	tests := []struct {
+		method string
+		query  string
+		body   string
+		code   int
+		want   string
+	}{
+		{"GET", "", "", 200, `{"level":"info","loggers":{"storage":"debug"}}`},
+		{"GET", "?logger=storage.cache", "", 200, `{"logger":"storage.cache","level":"debug"}`},
+		{"PUT", "", `{"level":"warn"}`, 200, `{"level":"warn"}`},
+		{"PUT", "", `{"logger":"rpc.*","level":"ERROR"}`, 200, `{"logger":"rpc","level":"error"}`},
+		{"GET", "", "", 200, `{"level":"warn","loggers":{"rpc":"error","storage":"debug"}}`},
+		{"GET", "?logger=http", "", 200, `{"logger":"http","level":"warn"}`},
+		{"PUT", "", `{"logger":"rpc"}`, 400, `{"error":"Must specify a logging level."}`},
+		{"PUT", "", `{"level":"verbose"}`, 400, ""},
+		{"PUT", "", `not json`, 400, ""},
+		{"PUT", "", `{"level":"debug","ttl":"soon"}`, 400, `{"error":"TTL must be a positive duration, as in \"10m\": \"soon\""}`},
+		{"PUT", "", `{"level":"debug","ttl":"-1s"}`, 400, ""},
+		{"POST", "", `{"level":"debug"}`, 405, `{"error":"Only GET and PUT are supported."}`},
+	}
+
+	for _, tt := range tests {
+		code, body := doLevelRequest(t, srv.URL+tt.query, tt.method, tt.body)
+		assert.Equal(t, tt.code, code, "Unexpected status for %s %s %s", tt.method, tt.query, tt.body)
+		if tt.want != "" {
+			assert.JSONEq(t, tt.want, body, "Unexpected response for %s %s %s", tt.method, tt.query, tt.body)
+		} else {
+			assert.Contains(t, body, `"error":`)
+		}
+	}
+	assert.Equal(t, bark.WarnLevel, r.Level(""), "Expected failed requests to leave levels unchanged")
+}
+
+func TestLevelRegistryHandlerTTL(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	code, body := doLevelRequest(t, srv.URL, "PUT", `{"logger":"storage","level":"debug","ttl":"20ms"}`)
+	require.Equal(t, 200, code)
+	assert.JSONEq(t, `{"logger":"storage","level":"debug"}`, body)
+	_, _ = doLevelRequest(t, srv.URL, "PUT", `{"level":"error","ttl":"20ms"}`)
+
+	assert.Eventually(t, func() bool {
+		return r.Level("storage") == bark.InfoLevel && r.Level("") == bark.InfoLevel
+	}, time.Second, 5*time.Millisecond, "Expected levels to revert after the TTL")
+	assert.Empty(t, r.Levels(), "Expected the temporary prefix to be unset")
+}
+
+func TestLevelRegistryHandlerLowersBackend(t *testing.T) {
+	logrusLogger, buf := getLogrusLogger()
+	logrusLogger.SetLevel(logrus.InfoLevel)
+
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	logger := bark.NewNamedLogger(bark.NewLoggerFromLogrus(logrusLogger), r)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	code, body := doLevelRequest(t, srv.URL, "PUT", `{"logger":"storage","level":"debug"}`)
+	require.Equal(t, 200, code)
+	assert.JSONEq(t, `{"logger":"storage","level":"debug"}`, body)
+	assert.Equal(t, logrus.DebugLevel, logrusLogger.GetLevel(), "Expected the logrus level to be lowered")
+
+	logger.(bark.NamedLogger).Named("storage").Debug("enabled")
+	logger.(bark.NamedLogger).Named("rpc").Debug("disabled")
+	assert.Equal(t, 1, strings.Count(buf.String(), `"msg":"enabled"`), "Expected debug entries for the storage logger")
+	assert.NotContains(t, buf.String(), "disabled", "Expected the registry to filter other loggers")
+}
+
+func TestLevelRegistryHandlerFixedBackend(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage", bark.DebugLevel)
+	logger, _ := barktest.New(bark.InfoLevel)
+	bark.NewNamedLogger(logger, r)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	tests := []struct {
+		method string
+		query  string
+		body   string
+		code   int
+		want   string
+	}{
+		{"GET", "", "", 200, `{"level":"info","loggers":{"storage":"info"}}`},
+		{"GET", "?logger=storage", "", 200, `{"logger":"storage","level":"info"}`},
+		{"PUT", "", `{"logger":"rpc","level":"debug"}`, 400,
+			`{"error":"Can't set the level: level debug is below the level info written by the logger, which can't be changed."}`},
+		{"PUT", "", `{"logger":"rpc","level":"warn"}`, 200, `{"logger":"rpc","level":"warn"}`},
+	}
+
+	for _, tt := range tests {
+		code, body := doLevelRequest(t, srv.URL+tt.query, tt.method, tt.body)
+		assert.Equal(t, tt.code, code, "Unexpected status for %s %s %s", tt.method, tt.query, tt.body)
+		assert.JSONEq(t, tt.want, body, "Unexpected response for %s %s %s", tt.method, tt.query, tt.body)
+	}
+}
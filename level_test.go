@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+)
+
+func TestLevelString(t *testing.T) {
+	tests := map[bark.Level]string{
+		bark.DebugLevel: "debug",
+		bark.InfoLevel:  "info",
+		bark.WarnLevel:  "warn",
+		bark.ErrorLevel: "error",
+		bark.PanicLevel: "panic",
+		bark.FatalLevel: "fatal",
+		bark.Level(42):  "Level(42)",
+	}
+
+	for level, expected := range tests {
+		assert.Equal(t, expected, level.String(), "Unexpected string for level %d", int(level))
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]bark.Level{
+		"debug":   bark.DebugLevel,
+		"DEBUG":   bark.DebugLevel,
+		"info":    bark.InfoLevel,
+		"":        bark.InfoLevel,
+		"warn":    bark.WarnLevel,
+		"Warning": bark.WarnLevel,
+		"error":   bark.ErrorLevel,
+		"panic":   bark.PanicLevel,
+		"fatal":   bark.FatalLevel,
+	}
+
+	for text, expected := range tests {
+		level, err := bark.ParseLevel(text)
+		require.NoError(t, err, "Unexpected error parsing %q", text)
+		assert.Equal(t, expected, level, "Unexpected level parsing %q", text)
+	}
+
+	_, err := bark.ParseLevel("verbose")
+	assert.Error(t, err, "Expected an error parsing an unknown level")
+
+	var nilLevel *bark.Level
+	assert.Error(t, nilLevel.UnmarshalText([]byte("info")), "Expected an error unmarshaling into a nil level")
+}
+
+func TestLevelJSON(t *testing.T) {
+	var config struct {
+		Level bark.Level `json:"level"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"level":"warning"}`), &config))
+	assert.Equal(t, bark.WarnLevel, config.Level)
+
+	out, err := json.Marshal(config)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"level":"warn"}`, string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"level":"loud"}`), &config), "Expected an error for an unknown level")
+}
+
+func TestLevelEnabled(t *testing.T) {
+	assert.True(t, bark.InfoLevel.Enabled(bark.ErrorLevel))
+	assert.True(t, bark.InfoLevel.Enabled(bark.InfoLevel))
+	assert.False(t, bark.InfoLevel.Enabled(bark.DebugLevel))
+}
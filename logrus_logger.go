@@ -18,7 +18,12 @@
 
 package bark
 
-import "github.com/sirupsen/logrus"
+import (
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
 
 // Interface provides indirection so Entry and Logger implementations can use exact same methods
 type logrusLoggerOrEntry interface {
@@ -34,24 +39,34 @@ type logrusLoggerOrEntry interface {
 	Fatalf(format string, args ...interface{})
 	Panic(args ...interface{})
 	Panicf(format string, args ...interface{})
+	Log(level logrus.Level, args ...interface{})
 	WithField(key string, value interface{}) *logrus.Entry
 	WithFields(keyValues logrus.Fields) *logrus.Entry
 	WithError(err error) *logrus.Entry
 }
 
+// Implemented by *os.File and other writers that buffer output
+type syncer interface {
+	Sync() error
+}
+
 // The bark-compliant Logger implementation.  Dispatches directly to wrapped logrus
-// instance for all methods except WithField, WithFields and WithError
+// instance for all methods except WithField, WithFields and WithError, and Fatal and
+// Fatalf if exit options were given
 type barkLogrusLogger struct {
 	logrusLoggerOrEntry
+
+	// exit is nil unless exit options were given
+	exit *exitConfig
 }
 
 // Note: logger is immutable, safe to use non-pointer receivers
-func newBarkLogrusLogger(loggerOrEntry logrusLoggerOrEntry) Logger {
-	return barkLogrusLogger{logrusLoggerOrEntry: loggerOrEntry}
+func newBarkLogrusLogger(loggerOrEntry logrusLoggerOrEntry, exit *exitConfig) Logger {
+	return barkLogrusLogger{logrusLoggerOrEntry: loggerOrEntry, exit: exit}
 }
 
 func (l barkLogrusLogger) WithField(key string, value interface{}) Logger {
-	return newBarkLogrusLogger(l.logrusLoggerOrEntry.WithField(key, value))
+	return newBarkLogrusLogger(l.logrusLoggerOrEntry.WithField(key, value), l.exit)
 }
 
 func (l barkLogrusLogger) WithFields(logFields LogFields) Logger {
@@ -59,11 +74,39 @@ func (l barkLogrusLogger) WithFields(logFields LogFields) Logger {
 		return l
 	}
 
-	return newBarkLogrusLogger(l.logrusLoggerOrEntry.WithFields(logrus.Fields(logFields.Fields())))
+	return newBarkLogrusLogger(l.logrusLoggerOrEntry.WithFields(logrus.Fields(logFields.Fields())), l.exit)
 }
 
 func (l barkLogrusLogger) WithError(err error) Logger {
-	return newBarkLogrusLogger(l.logrusLoggerOrEntry.WithError(err))
+	return newBarkLogrusLogger(l.logrusLoggerOrEntry.WithError(err), l.exit)
+}
+
+// Named records the logger's dotted name in the LoggerNameKey field
+func (l barkLogrusLogger) Named(name string) Logger {
+	if name == "" {
+		return l
+	}
+	return l.WithField(LoggerNameKey, joinName(l.Fields(), name))
+}
+
+func (l barkLogrusLogger) Fatal(args ...interface{}) {
+	if l.exit == nil {
+		l.logrusLoggerOrEntry.Fatal(args...)
+		return
+	}
+
+	l.Log(FatalLevel, args...)
+	l.exit.run(1)
+}
+
+func (l barkLogrusLogger) Fatalf(format string, args ...interface{}) {
+	if l.exit == nil {
+		l.logrusLoggerOrEntry.Fatalf(format, args...)
+		return
+	}
+
+	l.Logf(FatalLevel, format, args...)
+	l.exit.run(1)
 }
 
 func (l barkLogrusLogger) Fields() Fields {
@@ -73,3 +116,112 @@ func (l barkLogrusLogger) Fields() Fields {
 
 	return nil
 }
+
+func (l barkLogrusLogger) Enabled(level Level) bool {
+	return l.logrusLogger().IsLevelEnabled(toLogrusLevel(level))
+}
+
+func (l barkLogrusLogger) Level() Level {
+	return fromLogrusLevel(l.logrusLogger().GetLevel())
+}
+
+// SetLevel sets the level of the wrapped logrus logger, which is shared with every logger derived from it
+func (l barkLogrusLogger) SetLevel(level Level) {
+	l.logrusLogger().SetLevel(toLogrusLevel(level))
+}
+
+func (l barkLogrusLogger) Log(level Level, args ...interface{}) {
+	if l.Enabled(level) {
+		l.log(level, fmt.Sprint(args...))
+	}
+}
+
+func (l barkLogrusLogger) Logf(level Level, format string, args ...interface{}) {
+	if l.Enabled(level) {
+		l.log(level, fmt.Sprintf(format, args...))
+	}
+}
+
+// log writes an entry without exiting; logrus only exits from its Fatal methods
+func (l barkLogrusLogger) log(level Level, msg string) {
+	if level == PanicLevel {
+		// Logrus panics with the entry after writing it
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(*logrus.Entry); !ok {
+					panic(r)
+				}
+			}
+		}()
+	}
+
+	l.logrusLoggerOrEntry.Log(toLogrusLevel(level), msg)
+}
+
+// Sync flushes the logrus logger's output if it supports syncing, as files do. Files other than
+// regular files, such as the default os.Stderr, are skipped: syncing terminals and pipes fails.
+func (l barkLogrusLogger) Sync() error {
+	out := l.logrusLogger().Out
+	if f, ok := out.(*os.File); ok && !isRegularFile(f) {
+		return nil
+	}
+	if out, ok := out.(syncer); ok {
+		return out.Sync()
+	}
+
+	return nil
+}
+
+func isRegularFile(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode().IsRegular()
+}
+
+// logrusLogger returns the logrus logger that ultimately writes this logger's entries
+func (l barkLogrusLogger) logrusLogger() *logrus.Logger {
+	if entry, ok := l.logrusLoggerOrEntry.(*logrus.Entry); ok {
+		return entry.Logger
+	}
+
+	return l.logrusLoggerOrEntry.(*logrus.Logger)
+}
+
+func toLogrusLevel(level Level) logrus.Level {
+	switch level {
+	case DebugLevel:
+		return logrus.DebugLevel
+	case InfoLevel:
+		return logrus.InfoLevel
+	case WarnLevel:
+		return logrus.WarnLevel
+	case ErrorLevel:
+		return logrus.ErrorLevel
+	case PanicLevel:
+		return logrus.PanicLevel
+	case FatalLevel:
+		return logrus.FatalLevel
+	}
+
+	if level < DebugLevel {
+		return logrus.DebugLevel
+	}
+	return logrus.FatalLevel
+}
+
+// Logrus' trace level has no bark equivalent and is reported as DebugLevel
+func fromLogrusLevel(level logrus.Level) Level {
+	switch level {
+	case logrus.PanicLevel:
+		return PanicLevel
+	case logrus.FatalLevel:
+		return FatalLevel
+	case logrus.ErrorLevel:
+		return ErrorLevel
+	case logrus.WarnLevel:
+		return WarnLevel
+	case logrus.InfoLevel:
+		return InfoLevel
+	}
+
+	return DebugLevel
+}
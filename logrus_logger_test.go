@@ -21,6 +21,8 @@ package bark_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"testing"
@@ -31,6 +33,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
 )
 
 // Create a logrus logger that writes its out output to a buffer for inspection
@@ -210,6 +213,119 @@ func TestGetFields(t *testing.T) {
 	require.Equal(t, logger.Fields(), bark.Fields{logrus.ErrorKey: err})
 }
 
+func TestLevelEnabler(t *testing.T) {
+	logrusLogger, _ := getLogrusLogger()
+	logrusLogger.Level = logrus.WarnLevel
+
+	// Both plain loggers and loggers with fields attached should report levels
+	for _, logger := range []bark.Logger{
+		bark.NewLoggerFromLogrus(logrusLogger),
+		bark.NewLoggerFromLogrus(logrusLogger).WithField("foo", "bar"),
+	} {
+		levels, ok := logger.(bark.LevelEnabler)
+		require.True(t, ok, "Logrus wrapper should implement LevelEnabler")
+
+		assert.Equal(t, bark.WarnLevel, levels.Level())
+		assert.False(t, levels.Enabled(bark.DebugLevel))
+		assert.False(t, levels.Enabled(bark.InfoLevel))
+		assert.True(t, levels.Enabled(bark.WarnLevel))
+		assert.True(t, levels.Enabled(bark.ErrorLevel))
+	}
+
+	// Changes to the logrus level are picked up immediately
+	logger := bark.NewLoggerFromLogrus(logrusLogger).(bark.LevelEnabler)
+	logrusLogger.SetLevel(logrus.TraceLevel)
+	assert.Equal(t, bark.DebugLevel, logger.Level())
+	assert.True(t, logger.Enabled(bark.DebugLevel))
+}
+
+func TestLevelSetter(t *testing.T) {
+	logrusLogger, _ := getLogrusLogger()
+	setter, ok := bark.NewLoggerFromLogrus(logrusLogger).WithField("foo", "bar").(bark.LevelSetter)
+	require.True(t, ok, "Logrus wrapper should implement LevelSetter")
+
+	setter.SetLevel(bark.ErrorLevel)
+	assert.Equal(t, logrus.ErrorLevel, logrusLogger.GetLevel(), "Expected the shared logrus level to change")
+}
+
+func TestLevelLogger(t *testing.T) {
+	logAndValidate(t, func(barkLogger bark.Logger, logrusLogger *logrus.Logger) {
+		levels, ok := barkLogger.WithField("foo", "bar").(bark.LevelLogger)
+		require.True(t, ok, "Logrus wrapper should implement LevelLogger")
+		levels.Logf(bark.WarnLevel, "log%s", "f")
+		logrusLogger.WithField("foo", "bar").Logf(logrus.WarnLevel, "log%s", "f")
+	})
+
+	for _, level := range []bark.Level{bark.PanicLevel, bark.FatalLevel} {
+		logger, buffer := getBarkLogger()
+		assert.NotPanics(t, func() { logger.(bark.LevelLogger).Log(level, "oh ", "no") },
+			"Log should neither panic nor exit")
+
+		logged := parseLogBytes(buffer.Bytes())
+		assert.Equal(t, level.String(), logged["level"])
+		assert.Equal(t, "oh no", logged["msg"])
+	}
+
+	logrusLogger, buffer := getLogrusLogger()
+	logrusLogger.Level = logrus.ErrorLevel
+	logger := bark.NewLoggerFromLogrus(logrusLogger).(bark.LevelLogger)
+	logger.Log(bark.InfoLevel, "disabled")
+	logger.Logf(bark.DebugLevel, "disabled %d", 1)
+	assert.Equal(t, 0, buffer.Len(), "Disabled levels should not be logged")
+}
+
+// Records calls to Sync, like an *os.File would flush them
+type syncBuffer struct {
+	bytes.Buffer
+	syncs int
+	err   error
+}
+
+func (b *syncBuffer) Sync() error {
+	b.syncs++
+	return b.err
+}
+
+func TestSync(t *testing.T) {
+	// Non-syncing outputs are a no-op
+	logger, _ := getBarkLogger()
+	syncer, ok := logger.(bark.Syncer)
+	require.True(t, ok, "Logrus wrapper should implement Syncer")
+	assert.NoError(t, syncer.Sync())
+
+	// Syncing outputs are synced, even from loggers with fields
+	logrusLogger, _ := getLogrusLogger()
+	out := &syncBuffer{}
+	logrusLogger.Out = out
+	logger = bark.NewLoggerFromLogrus(logrusLogger).WithField("foo", "bar")
+	require.NoError(t, logger.(bark.Syncer).Sync())
+	assert.Equal(t, 1, out.syncs, "Expected output to be synced")
+
+	out.err = errors.New("sync failed")
+	assert.Equal(t, out.err, logger.(bark.Syncer).Sync(), "Expected sync error to be returned")
+
+	// Files are synced
+	f, err := ioutil.TempFile("", "bark-sync")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	logrusLogger.Out = f
+	logger.Info("synced")
+	assert.NoError(t, logger.(bark.Syncer).Sync())
+
+	// Terminals and pipes can't be synced, so they're skipped, as with logrus's default os.Stderr
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+	defer w.Close()
+	for _, out := range []*os.File{os.Stderr, os.Stdout, w} {
+		logrusLogger.Out = out
+		assert.NoError(t, logger.(bark.Syncer).Sync(), "Expected %v not to be synced", out.Name())
+	}
+	assert.NoError(t, bark.NewLoggerFromLogrus(logrus.New()).(bark.Syncer).Sync())
+}
+
 func doPanic(t *testing.T, panicker func(...interface{})) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -285,6 +401,66 @@ func TestFatalf(t *testing.T) {
 	validateOutput(t, barkStderr, logrusStderr)
 }
 
+func TestFatalExitOptions(t *testing.T) {
+	logrusLogger, buffer := getLogrusLogger()
+
+	var events []string
+	logger := bark.NewLoggerFromLogrus(logrusLogger,
+		bark.WithPreExitHook(func() {
+			assert.Contains(t, buffer.String(), "fatal error", "Expected hooks to run after the entry is written")
+			events = append(events, "hook")
+		}),
+		bark.WithExitFunc(func(code int) { events = append(events, fmt.Sprint("exit ", code)) }),
+	)
+
+	logger.Fatal("fatal error")
+	assert.Equal(t, "fatal", parseLogBytes(buffer.Bytes())["level"])
+	buffer.Reset()
+
+	logger.WithField("foo", "bar").WithFields(bark.Fields{"baz": 1}).WithError(errors.New("oh no")).Fatalf("fatal %s", "error")
+	logged := parseLogBytes(buffer.Bytes())
+	assert.Equal(t, "fatal error", logged["msg"])
+	assert.Equal(t, "bar", logged["foo"], "Expected fields to be kept")
+
+	assert.Equal(t, []string{"hook", "exit 1", "hook", "exit 1"}, events, "Expected derived loggers to keep exit options")
+}
+
+func TestFatalExitsThroughLogrus(t *testing.T) {
+	logrusLogger, buffer := getLogrusLogger()
+
+	var events []string
+	logrusLogger.ExitFunc = func(code int) { events = append(events, fmt.Sprint("logrus exit ", code)) }
+
+	bark.NewLoggerFromLogrus(logrusLogger).Fatal("fatal error")
+	assert.Contains(t, buffer.String(), "fatal error")
+	assert.Equal(t, []string{"logrus exit 1"}, events, "Expected logrus' exit function by default")
+
+	events = nil
+	hook := bark.WithPreExitHook(func() { events = append(events, "hook") })
+	bark.NewLoggerFromLogrus(logrusLogger, hook).Fatalf("fatal %s", "error")
+	assert.Equal(t, []string{"hook", "logrus exit 1"}, events, "Expected logrus' exit function without WithExitFunc")
+}
+
+func TestNamed(t *testing.T) {
+	logger, buffer := getBarkLogger()
+	named, ok := logger.(bark.NamedLogger)
+	require.True(t, ok, "Logrus wrapper should implement NamedLogger")
+	assert.Equal(t, logger, named.Named(""), "Expected empty names to be a no-op")
+
+	child := named.Named("storage").WithField("foo", "bar").(bark.NamedLogger).Named("cache")
+	assert.Equal(t, bark.Fields{bark.LoggerNameKey: "storage.cache", "foo": "bar"}, child.Fields())
+
+	child.Info("hello")
+	assert.Equal(t, "storage.cache", parseLogBytes(buffer.Bytes())[bark.LoggerNameKey])
+}
+
+func TestLogrusConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return logger
+	})
+}
+
 func TestNopLogger(t *testing.T) {
 	assert.NotPanics(t, func() {
 		logger := bark.NewNopLogger()
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mocks
+
+import bark "github.com/uber-common/bark"
+import mock "github.com/stretchr/testify/mock"
+
+// Logger is an autogenerated mock type for the Logger type
+type Logger struct {
+	mock.Mock
+}
+
+// Debug provides a mock function with given fields: args
+func (_m *Logger) Debug(args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Debugf provides a mock function with given fields: format, args
+func (_m *Logger) Debugf(format string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Error provides a mock function with given fields: args
+func (_m *Logger) Error(args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Errorf provides a mock function with given fields: format, args
+func (_m *Logger) Errorf(format string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Fatal provides a mock function with given fields: args
+func (_m *Logger) Fatal(args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Fatalf provides a mock function with given fields: format, args
+func (_m *Logger) Fatalf(format string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Fields provides a mock function with given fields:
+func (_m *Logger) Fields() bark.Fields {
+	ret := _m.Called()
+
+	var r0 bark.Fields
+	if rf, ok := ret.Get(0).(func() bark.Fields); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bark.Fields)
+		}
+	}
+
+	return r0
+}
+
+// Info provides a mock function with given fields: args
+func (_m *Logger) Info(args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Infof provides a mock function with given fields: format, args
+func (_m *Logger) Infof(format string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Panic provides a mock function with given fields: args
+func (_m *Logger) Panic(args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Panicf provides a mock function with given fields: format, args
+func (_m *Logger) Panicf(format string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Warn provides a mock function with given fields: args
+func (_m *Logger) Warn(args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// Warnf provides a mock function with given fields: format, args
+func (_m *Logger) Warnf(format string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, format)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// WithError provides a mock function with given fields: err
+func (_m *Logger) WithError(err error) bark.Logger {
+	ret := _m.Called(err)
+
+	var r0 bark.Logger
+	if rf, ok := ret.Get(0).(func(error) bark.Logger); ok {
+		r0 = rf(err)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bark.Logger)
+		}
+	}
+
+	return r0
+}
+
+// WithField provides a mock function with given fields: key, value
+func (_m *Logger) WithField(key string, value interface{}) bark.Logger {
+	ret := _m.Called(key, value)
+
+	var r0 bark.Logger
+	if rf, ok := ret.Get(0).(func(string, interface{}) bark.Logger); ok {
+		r0 = rf(key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bark.Logger)
+		}
+	}
+
+	return r0
+}
+
+// WithFields provides a mock function with given fields: keyValues
+func (_m *Logger) WithFields(keyValues bark.LogFields) bark.Logger {
+	ret := _m.Called(keyValues)
+
+	var r0 bark.Logger
+	if rf, ok := ret.Get(0).(func(bark.LogFields) bark.Logger); ok {
+		r0 = rf(keyValues)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bark.Logger)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mocks_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/mocks"
+)
+
+var (
+	_ bark.Logger            = (*mocks.Logger)(nil)
+	_ bark.StatsReporter     = (*mocks.StatsReporter)(nil)
+	_ bark.HistogramReporter = (*mocks.StatsReporter)(nil)
+)
+
+func TestLoggerChaining(t *testing.T) {
+	err := errors.New("test error")
+	logger, withField, withFields := &mocks.Logger{}, &mocks.Logger{}, &mocks.Logger{}
+
+	logger.On("WithField", "foo", "bar").Return(withField)
+	withField.On("WithFields", bark.Fields{"baz": 1}).Return(withFields)
+	withFields.On("WithError", err).Return(withFields)
+	withFields.On("Fields").Return(bark.Fields{"foo": "bar", "baz": 1, "error": err})
+	withFields.On("Infof", "hello %s", "world")
+	withFields.On("Error", mock.Anything, mock.Anything)
+
+	l := logger.WithField("foo", "bar").WithFields(bark.Fields{"baz": 1}).WithError(err)
+	l.Infof("hello %s", "world")
+	l.Error("oh", "no")
+	assert.Equal(t, bark.Fields{"foo": "bar", "baz": 1, "error": err}, l.Fields())
+
+	logger.AssertExpectations(t)
+	withField.AssertExpectations(t)
+	withFields.AssertExpectations(t)
+	withFields.AssertCalled(t, "Error", "oh", "no")
+}
+
+func TestStatsReporter(t *testing.T) {
+	reporter := &mocks.StatsReporter{}
+	reporter.On("IncCounter", "foo", bark.Tags{"tag": "val"}, int64(7))
+	reporter.On("UpdateGauge", "bar", bark.Tags(nil), int64(123))
+	reporter.On("RecordTimer", "baz", mock.Anything, time.Second)
+	reporter.On("RecordHistogram", "qux", bark.Tags(nil), 4.5)
+
+	var r bark.StatsReporter = reporter
+	r.IncCounter("foo", bark.Tags{"tag": "val"}, 7)
+	r.UpdateGauge("bar", nil, 123)
+	r.RecordTimer("baz", bark.Tags{"tag": "val"}, time.Second)
+	r.(bark.HistogramReporter).RecordHistogram("qux", nil, 4.5)
+
+	reporter.AssertExpectations(t)
+}
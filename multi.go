@@ -0,0 +1,286 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// NewMultiLogger creates a logger that writes every entry to all of the given loggers, as
+// when migrating from one logging backend to another. WithField, WithFields and WithError
+// apply to every child, and Fields merges the children's fields, with later loggers taking
+// precedence for shared keys.
+//
+// Fatal and Panic write the entry to every child before exiting or panicking, exactly once.
+// Children implementing LevelLogger write these entries at their own level; otherwise, Fatal
+// entries are written at error level, and each child's panic is recovered. Fatal writes the
+// entry to the first child last, with its own Fatal method, so that the process exits as
+// configured for that child, for example with WithExitFunc and WithPreExitHook; before then,
+// every other child implementing Syncer is synced.
+//
+// The returned logger also implements LevelEnabler, LevelLogger, Syncer and CallerSkipper. Children
+// implementing CallerSkipper attribute entries to the multi logger's callers.
+func NewMultiLogger(loggers ...Logger) Logger {
+	m := &multiLogger{loggers: make([]Logger, len(loggers))}
+	for i, l := range loggers {
+		m.loggers[i] = SkipCaller(l, _multiCallerSkip)
+	}
+	return m
+}
+
+// _multiCallerSkip is the number of stack frames between the multi logger's callers and its
+// children: every method calls the children through log, logf, fatal or fatalf.
+const _multiCallerSkip = 2
+
+type multiLogger struct {
+	loggers []Logger
+}
+
+func (m *multiLogger) Debug(args ...interface{}) {
+	m.log(m.loggers, DebugLevel, args)
+}
+
+func (m *multiLogger) Debugf(format string, args ...interface{}) {
+	m.logf(m.loggers, DebugLevel, format, args)
+}
+
+func (m *multiLogger) Info(args ...interface{}) {
+	m.log(m.loggers, InfoLevel, args)
+}
+
+func (m *multiLogger) Infof(format string, args ...interface{}) {
+	m.logf(m.loggers, InfoLevel, format, args)
+}
+
+func (m *multiLogger) Warn(args ...interface{}) {
+	m.log(m.loggers, WarnLevel, args)
+}
+
+func (m *multiLogger) Warnf(format string, args ...interface{}) {
+	m.logf(m.loggers, WarnLevel, format, args)
+}
+
+func (m *multiLogger) Error(args ...interface{}) {
+	m.log(m.loggers, ErrorLevel, args)
+}
+
+func (m *multiLogger) Errorf(format string, args ...interface{}) {
+	m.logf(m.loggers, ErrorLevel, format, args)
+}
+
+func (m *multiLogger) Fatal(args ...interface{}) {
+	if len(m.loggers) == 0 {
+		os.Exit(1)
+	}
+
+	rest := m.rest()
+	m.log(rest.loggers, FatalLevel, args)
+	rest.Sync()
+	m.fatal(args)
+}
+
+func (m *multiLogger) Fatalf(format string, args ...interface{}) {
+	if len(m.loggers) == 0 {
+		os.Exit(1)
+	}
+
+	rest := m.rest()
+	m.logf(rest.loggers, FatalLevel, format, args)
+	rest.Sync()
+	m.fatalf(format, args)
+}
+
+func (m *multiLogger) Panic(args ...interface{}) {
+	m.log(m.loggers, PanicLevel, args)
+	panic(fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Panicf(format string, args ...interface{}) {
+	m.logf(m.loggers, PanicLevel, format, args)
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Log(level Level, args ...interface{}) {
+	m.log(m.loggers, level, args)
+}
+
+func (m *multiLogger) Logf(level Level, format string, args ...interface{}) {
+	m.logf(m.loggers, level, format, args)
+}
+
+// log writes an entry to each of the given children
+func (m *multiLogger) log(loggers []Logger, level Level, args []interface{}) {
+	for _, l := range loggers {
+		if ll, ok := l.(LevelLogger); ok {
+			ll.Log(level, args...)
+			continue
+		}
+
+		switch level {
+		case DebugLevel:
+			l.Debug(args...)
+		case InfoLevel:
+			l.Info(args...)
+		case WarnLevel:
+			l.Warn(args...)
+		case PanicLevel:
+			recoverPanic(func() { l.Panic(args...) })
+		default:
+			l.Error(args...)
+		}
+	}
+}
+
+// logf writes a formatted entry to each of the given children
+func (m *multiLogger) logf(loggers []Logger, level Level, format string, args []interface{}) {
+	for _, l := range loggers {
+		if ll, ok := l.(LevelLogger); ok {
+			ll.Logf(level, format, args...)
+			continue
+		}
+
+		switch level {
+		case DebugLevel:
+			l.Debugf(format, args...)
+		case InfoLevel:
+			l.Infof(format, args...)
+		case WarnLevel:
+			l.Warnf(format, args...)
+		case PanicLevel:
+			recoverPanic(func() { l.Panicf(format, args...) })
+		default:
+			l.Errorf(format, args...)
+		}
+	}
+}
+
+// fatal writes a Fatal entry to the first child, which exits. Like log, it adds a stack frame
+// between Fatal and the child.
+func (m *multiLogger) fatal(args []interface{}) {
+	m.loggers[0].Fatal(args...)
+}
+
+// fatalf writes a formatted Fatal entry to the first child, which exits
+func (m *multiLogger) fatalf(format string, args []interface{}) {
+	m.loggers[0].Fatalf(format, args...)
+}
+
+func (m *multiLogger) WithField(key string, value interface{}) Logger {
+	children := &multiLogger{loggers: make([]Logger, len(m.loggers))}
+	for i, l := range m.loggers {
+		children.loggers[i] = l.WithField(key, value)
+	}
+	return children
+}
+
+func (m *multiLogger) WithFields(keyValues LogFields) Logger {
+	if keyValues == nil {
+		return m
+	}
+
+	children := &multiLogger{loggers: make([]Logger, len(m.loggers))}
+	for i, l := range m.loggers {
+		children.loggers[i] = l.WithFields(keyValues)
+	}
+	return children
+}
+
+func (m *multiLogger) WithError(err error) Logger {
+	children := &multiLogger{loggers: make([]Logger, len(m.loggers))}
+	for i, l := range m.loggers {
+		children.loggers[i] = l.WithError(err)
+	}
+	return children
+}
+
+func (m *multiLogger) Fields() Fields {
+	var merged Fields
+	for _, l := range m.loggers {
+		fields := l.Fields()
+		if len(fields) == 0 {
+			continue
+		}
+		if merged == nil {
+			merged = make(Fields, len(fields))
+		}
+		for k, v := range fields {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (m *multiLogger) AddCallerSkip(skip int) Logger {
+	children := &multiLogger{loggers: make([]Logger, len(m.loggers))}
+	for i, l := range m.loggers {
+		children.loggers[i] = SkipCaller(l, skip)
+	}
+	return children
+}
+
+// Enabled reports whether any child writes entries at the given level
+func (m *multiLogger) Enabled(level Level) bool {
+	for _, l := range m.loggers {
+		if levels, ok := l.(LevelEnabler); !ok || levels.Enabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Level returns the lowest level written by any child
+func (m *multiLogger) Level() Level {
+	min := FatalLevel
+	for _, l := range m.loggers {
+		levels, ok := l.(LevelEnabler)
+		if !ok {
+			return DebugLevel
+		}
+		if lvl := levels.Level(); lvl < min {
+			min = lvl
+		}
+	}
+	return min
+}
+
+// Sync syncs every child, returning all of their errors
+func (m *multiLogger) Sync() error {
+	var errs []error
+	for _, l := range m.loggers {
+		if s, ok := l.(Syncer); ok {
+			if err := s.Sync(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// rest returns a logger for every child but the first, which exits after Fatal entries
+func (m *multiLogger) rest() *multiLogger {
+	return &multiLogger{loggers: m.loggers[1:]}
+}
+
+// recoverPanic calls f, recovering from any panic
+func recoverPanic(f func()) {
+	defer func() { recover() }()
+	f()
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+// Hides the optional interfaces implemented by the wrapped logger
+type plainLogger struct {
+	bark.Logger
+}
+
+func TestMultiLogger(t *testing.T) {
+	first, firstLogs := barktest.New(bark.DebugLevel)
+	second, secondLogs := barktest.New(bark.WarnLevel)
+	logger := bark.NewMultiLogger(first, second)
+
+	logger.Debug("debug")
+	logger.Infof("info %d", 1)
+	logger.Warn("warn")
+	logger.Errorf("error %d", 1)
+
+	assert.Equal(t, 4, firstLogs.Len())
+	assert.Equal(t, 2, secondLogs.Len(), "Expected children to apply their own levels")
+	assert.Equal(t, "error 1", secondLogs.All()[1].Message)
+}
+
+func TestMultiLoggerComparable(t *testing.T) {
+	first, _ := barktest.New(bark.DebugLevel)
+	var a, b bark.Logger = bark.NewMultiLogger(first), bark.NewMultiLogger(first)
+	assert.NotPanics(t, func() {
+		assert.True(t, a == a, "Expected a multi logger to equal itself")
+		assert.False(t, a == b, "Expected distinct multi loggers not to be equal")
+	})
+	assert.Equal(t, a, a.WithFields(nil), "Expected nil fields to be a no-op")
+}
+
+func TestMultiLoggerFields(t *testing.T) {
+	err := errors.New("oh no")
+	first, firstLogs := barktest.New(bark.DebugLevel)
+	second, secondLogs := barktest.New(bark.DebugLevel)
+	logger := bark.NewMultiLogger(first, second.WithField("foo", "baz"))
+
+	assert.Equal(t, bark.Fields{"foo": "baz"}, logger.Fields())
+	assert.Equal(t, logger, logger.WithFields(nil), "Expected nil fields to be a no-op")
+	assert.Nil(t, bark.NewMultiLogger(first, second).Fields(), "Expected no fields")
+
+	child := logger.WithField("foo", "bar").WithFields(bark.Fields{"baz": 1}).WithError(err)
+	assert.Equal(t, bark.Fields{"foo": "bar", "baz": 1, "error": err}, child.Fields())
+	child.Info("hello")
+
+	for _, logs := range []*barktest.ObservedLogs{firstLogs, secondLogs} {
+		entries := logs.All()
+		require.Equal(t, 1, len(entries))
+		assert.Equal(t, bark.Fields{"foo": "bar", "baz": 1, "error": err}, entries[0].Fields)
+	}
+}
+
+func TestMultiLoggerPanic(t *testing.T) {
+	leveled, leveledLogs := barktest.New(bark.DebugLevel)
+	plain, plainLogs := barktest.New(bark.DebugLevel)
+	logger := bark.NewMultiLogger(leveled, plainLogger{plain})
+
+	assert.PanicsWithValue(t, "oh no", func() { logger.Panic("oh ", "no") })
+	assert.PanicsWithValue(t, "oh no", func() { logger.Panicf("oh %s", "no") })
+
+	assert.Equal(t, 2, leveledLogs.FilterLevel(bark.PanicLevel).FilterMessage("oh no").Len())
+	assert.Equal(t, 2, plainLogs.FilterLevel(bark.PanicLevel).FilterMessage("oh no").Len(),
+		"Expected loggers without LevelLogger to log before panicking once")
+}
+
+func TestMultiLoggerLevelLogger(t *testing.T) {
+	leveled, leveledLogs := barktest.New(bark.DebugLevel)
+	plain, plainLogs := barktest.New(bark.DebugLevel)
+	logger, ok := bark.NewMultiLogger(leveled, plainLogger{plain}).(bark.LevelLogger)
+	require.True(t, ok, "Expected multi logger to implement LevelLogger")
+
+	for _, level := range []bark.Level{bark.DebugLevel, bark.InfoLevel, bark.WarnLevel, bark.ErrorLevel, bark.PanicLevel} {
+		logger.Log(level, "hello")
+		logger.Logf(level, "hello %s", "world")
+	}
+	logger.Log(bark.FatalLevel, "fatal")
+	logger.Logf(bark.FatalLevel, "fatal %s", "world")
+
+	assert.Equal(t, 12, leveledLogs.Len())
+	assert.Equal(t, 2, leveledLogs.FilterLevel(bark.FatalLevel).Len())
+	for _, level := range []bark.Level{bark.DebugLevel, bark.InfoLevel, bark.WarnLevel, bark.PanicLevel} {
+		assert.Equal(t, 2, plainLogs.FilterLevel(level).Len(), "Unexpected %v entries", level)
+	}
+	assert.Equal(t, 4, plainLogs.FilterLevel(bark.ErrorLevel).Len(),
+		"Expected fatal entries to be logged at error level by loggers without LevelLogger")
+}
+
+func TestMultiLoggerLevelEnabler(t *testing.T) {
+	info, _ := barktest.New(bark.InfoLevel)
+	warn, _ := barktest.New(bark.WarnLevel)
+
+	levels := bark.NewMultiLogger(warn, info).(bark.LevelEnabler)
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.False(t, levels.Enabled(bark.DebugLevel))
+	assert.True(t, levels.Enabled(bark.InfoLevel))
+
+	levels = bark.NewMultiLogger(warn, plainLogger{info}).(bark.LevelEnabler)
+	assert.Equal(t, bark.DebugLevel, levels.Level(), "Expected loggers without LevelEnabler to enable every level")
+	assert.True(t, levels.Enabled(bark.DebugLevel))
+
+	levels = bark.NewMultiLogger().(bark.LevelEnabler)
+	assert.False(t, levels.Enabled(bark.FatalLevel))
+}
+
+func TestMultiLoggerSync(t *testing.T) {
+	first, second := &syncBuffer{}, &syncBuffer{err: errors.New("sync failed")}
+	logger := bark.NewMultiLogger(newSyncingLogger(first), newSyncingLogger(second), bark.NewNopLogger())
+
+	err := logger.(bark.Syncer).Sync()
+	assert.EqualError(t, err, "sync failed")
+	assert.Equal(t, 1, first.syncs)
+	assert.Equal(t, 1, second.syncs)
+}
+
+func newSyncingLogger(out *syncBuffer) bark.Logger {
+	logrusLogger, _ := getLogrusLogger()
+	logrusLogger.Out = out
+	return bark.NewLoggerFromLogrus(logrusLogger)
+}
+
+func TestMultiLoggerFatal(t *testing.T) {
+	for _, how := range []string{"multi.Fatal", "multi.Fatalf"} {
+		output := execFatalTool(t, how)
+		lines := bytes.Split(bytes.TrimSpace(output), []byte("\n"))
+		require.Equal(t, 2, len(lines), "Expected both children to log before exiting")
+		for _, line := range lines {
+			assert.Equal(t, "fatal", parseLogBytes(line)["level"])
+		}
+	}
+}
+
+func TestMultiLoggerFatalExitOptions(t *testing.T) {
+	var events []string
+	first, firstLogs := barktest.New(bark.DebugLevel,
+		bark.WithPreExitHook(func() { events = append(events, "hook") }),
+		bark.WithExitFunc(func(code int) { events = append(events, fmt.Sprint("exit ", code)) }),
+	)
+	second, secondLogs := barktest.New(bark.DebugLevel)
+	out := &syncBuffer{}
+	logger := bark.NewMultiLogger(first, second, newSyncingLogger(out))
+
+	logger.Fatal("fatal")
+	logger.Fatalf("fatal %s", "world")
+
+	assert.Equal(t, []string{"hook", "exit 1", "hook", "exit 1"}, events, "Expected the first child to exit once per entry")
+	assert.Equal(t, 2, firstLogs.FilterLevel(bark.FatalLevel).Len())
+	assert.Equal(t, 2, secondLogs.FilterLevel(bark.FatalLevel).Len())
+	assert.Equal(t, 2, out.syncs, "Expected the other children to be synced before exiting")
+}
+
+func TestMultiLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		first, _ := getBarkLogger()
+		second, _ := barktest.New(bark.DebugLevel)
+		return bark.NewMultiLogger(first, second)
+	})
+}
+
+func TestMultiLoggerSkipsCaller(t *testing.T) {
+	assertSkipsCaller(t, func(l bark.Logger) bark.Logger {
+		return bark.NewMultiLogger(l, bark.NewNopLogger())
+	})
+
+	observer, logs := barktest.New(bark.DebugLevel)
+	assertAddCallerSkip(t, bark.NewNamedLogger(bark.NewMultiLogger(observer), nil), logs)
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LoggerNameKey is the field holding a logger's name, for Loggers that record names as fields.
+// It matches the key zap's JSON encoder uses by default.
+const LoggerNameKey = "logger"
+
+// joinName appends name to the name recorded in fields, if any
+func joinName(fields Fields, name string) string {
+	if parent, ok := fields[LoggerNameKey].(string); ok && parent != "" {
+		return parent + "." + name
+	}
+	return name
+}
+
+// LevelRegistry holds the minimum level for named loggers, configured by name prefix in the
+// manner of log4j's logger hierarchy. Setting a level for "storage" applies to the loggers named
+// "storage", "storage.cache" and so on, unless a longer prefix such as "storage.cache" has a level
+// of its own; loggers matching no prefix use the root level. Levels may be changed at any time,
+// and take effect immediately for loggers created by NewNamedLogger.
+//
+// Named loggers can't write levels that the logger they wrap doesn't write. So the first logger wrapped
+// by NewNamedLogger with a registry becomes its backend. If it implements LevelSetter, its level follows
+// the lowest level in the registry, but never rises above the level it had when it became the backend;
+// removing or reverting a lower level raises it again. Otherwise, the backend's level bounds the levels
+// reported by ServeHTTP.
+//
+// LevelRegistry is also an http.Handler for viewing and changing levels at runtime; see ServeHTTP.
+type LevelRegistry struct {
+	mu     sync.RWMutex
+	root   Level
+	levels map[string]Level
+
+	// versions counts changes to each prefix, so that reverting a temporary level
+	// doesn't undo a later change
+	versions map[string]uint64
+
+	// baselines holds the setting to restore for prefixes with a temporary level, so
+	// that extending a temporary level still restores the setting from before it
+	baselines map[string]baseline
+
+	// backend is the first logger wrapped by NewNamedLogger with this registry, and
+	// backendLevel its level at the time
+	backend      LevelEnabler
+	backendLevel Level
+}
+
+// baseline is a prefix's setting from before a temporary level was set
+type baseline struct {
+	level Level
+	ok    bool
+}
+
+// NewLevelRegistry creates a LevelRegistry with the given root level.
+func NewLevelRegistry(root Level) *LevelRegistry {
+	return &LevelRegistry{
+		root:      root,
+		levels:    make(map[string]Level),
+		versions:  make(map[string]uint64),
+		baselines: make(map[string]baseline),
+	}
+}
+
+// SetLevel sets the level for loggers named by the given prefix, which may end in ".*" as in
+// "storage.*". The prefixes "" and "*" set the root level.
+func (r *LevelRegistry) SetLevel(prefix string, level Level) {
+	prefix = normalizePrefix(prefix)
+
+	r.mu.Lock()
+	delete(r.baselines, prefix)
+	r.set(prefix, level, true)
+	r.mu.Unlock()
+}
+
+// SetLevelFor sets the level for loggers named by the given prefix, as SetLevel does, then restores
+// the setting from before the first of any consecutive calls once ttl has elapsed, unless the prefix
+// has been changed again in the meantime. Calling SetLevelFor again before then extends the temporary
+// level.
+func (r *LevelRegistry) SetLevelFor(prefix string, level Level, ttl time.Duration) {
+	prefix = normalizePrefix(prefix)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.baselines[prefix]; !ok {
+		prev, wasSet := r.root, true
+		if prefix != "" {
+			prev, wasSet = r.levels[prefix]
+		}
+		r.baselines[prefix] = baseline{level: prev, ok: wasSet}
+	}
+	r.set(prefix, level, true)
+
+	version := r.versions[prefix]
+	time.AfterFunc(ttl, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.versions[prefix] != version {
+			return
+		}
+		if prev, ok := r.baselines[prefix]; ok {
+			delete(r.baselines, prefix)
+			r.set(prefix, prev.level, prev.ok)
+		}
+	})
+}
+
+// UnsetLevel removes the level set for the given prefix, so that matching loggers use the level
+// of a shorter prefix instead. The root level can't be unset.
+func (r *LevelRegistry) UnsetLevel(prefix string) {
+	prefix = normalizePrefix(prefix)
+	if prefix == "" {
+		return
+	}
+
+	r.mu.Lock()
+	delete(r.baselines, prefix)
+	r.set(prefix, 0, false)
+	r.mu.Unlock()
+}
+
+// set sets or unsets the level for a normalized prefix, then updates the backend's level; the caller
+// must hold the lock
+func (r *LevelRegistry) set(prefix string, level Level, ok bool) {
+	r.versions[prefix]++
+	switch {
+	case prefix == "":
+		r.root = level
+	case ok:
+		r.levels[prefix] = level
+	default:
+		delete(r.levels, prefix)
+	}
+	r.updateBackend()
+}
+
+// updateBackend sets the backend's level, if it can be changed, to the lowest level in the registry
+// or the backend's original level, whichever is lower; the caller must hold the lock
+func (r *LevelRegistry) updateBackend() {
+	s, ok := r.backend.(LevelSetter)
+	if !ok {
+		return
+	}
+
+	min := r.backendLevel
+	if r.root < min {
+		min = r.root
+	}
+	for _, level := range r.levels {
+		if level < min {
+			min = level
+		}
+	}
+	if r.backend.Level() != min {
+		s.SetLevel(min)
+	}
+}
+
+// setBackend makes the logger the registry's backend, unless it already has one
+func (r *LevelRegistry) setBackend(logger Logger) {
+	levels, ok := logger.(LevelEnabler)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	if r.backend == nil {
+		r.backend, r.backendLevel = levels, levels.Level()
+		r.updateBackend()
+	}
+	r.mu.Unlock()
+}
+
+// checkLevel returns an error if named loggers can't write entries at the given level, because the
+// backend doesn't write them and its level can't be changed
+func (r *LevelRegistry) checkLevel(level Level) error {
+	r.mu.RLock()
+	backend := r.backend
+	r.mu.RUnlock()
+
+	if _, ok := backend.(LevelSetter); ok || backend == nil {
+		return nil
+	}
+	if min := backend.Level(); level < min {
+		return fmt.Errorf("level %v is below the level %v written by the logger, which can't be changed", level, min)
+	}
+	return nil
+}
+
+// effectiveLevel returns the higher of the given level and the backend's level
+func (r *LevelRegistry) effectiveLevel(level Level) Level {
+	r.mu.RLock()
+	backend := r.backend
+	r.mu.RUnlock()
+
+	if backend != nil {
+		if min := backend.Level(); min > level {
+			return min
+		}
+	}
+	return level
+}
+
+// Levels returns the levels set for prefixes other than the root.
+func (r *LevelRegistry) Levels() map[string]Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	levels := make(map[string]Level, len(r.levels))
+	for prefix, level := range r.levels {
+		levels[prefix] = level
+	}
+	return levels
+}
+
+// Level returns the level for the logger with the given name, from the longest prefix with a level.
+func (r *LevelRegistry) Level(name string) Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for name != "" {
+		if level, ok := r.levels[name]; ok {
+			return level
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return r.root
+}
+
+// Enabled reports whether the logger with the given name writes entries at the given level.
+func (r *LevelRegistry) Enabled(name string, level Level) bool {
+	return r.Level(name).Enabled(level)
+}
+
+func normalizePrefix(prefix string) string {
+	if prefix == "*" {
+		return ""
+	}
+	return strings.TrimSuffix(prefix, ".*")
+}
+
+// NewNamedLogger wraps a logger so that it implements NamedLogger, and so that the levels it writes
+// are set by name in the registry. Named is forwarded to the wrapped logger if it implements
+// NamedLogger; otherwise, names are recorded in the LoggerNameKey field.
+//
+// Named loggers only write the levels written by the wrapped logger. If the registry has no backend
+// yet, the wrapped logger becomes its backend, so that setting lower levels in the registry lowers the
+// wrapped logger's level, until they're removed, if it implements LevelSetter. Panic and Fatal entries
+// are always written.
+//
+// If the wrapped logger implements CallerSkipper, so does the returned logger, and entries are
+// attributed to its callers rather than to the named logger.
+func NewNamedLogger(logger Logger, registry *LevelRegistry) Logger {
+	if registry != nil {
+		registry.setBackend(logger)
+	}
+	return &namedLogger{Logger: SkipCaller(logger, 1), registry: registry}
+}
+
+type namedLogger struct {
+	Logger
+
+	registry *LevelRegistry
+	name     string
+}
+
+func (l *namedLogger) Named(name string) Logger {
+	if name == "" {
+		return l
+	}
+
+	child := &namedLogger{registry: l.registry, name: name}
+	if l.name != "" {
+		child.name = l.name + "." + name
+	}
+	if named, ok := l.Logger.(NamedLogger); ok {
+		child.Logger = named.Named(name)
+	} else {
+		child.Logger = l.Logger.WithField(LoggerNameKey, child.name)
+	}
+	return child
+}
+
+func (l *namedLogger) Debug(args ...interface{}) {
+	if l.Enabled(DebugLevel) {
+		l.Logger.Debug(args...)
+	}
+}
+
+func (l *namedLogger) Debugf(format string, args ...interface{}) {
+	if l.Enabled(DebugLevel) {
+		l.Logger.Debugf(format, args...)
+	}
+}
+
+func (l *namedLogger) Info(args ...interface{}) {
+	if l.Enabled(InfoLevel) {
+		l.Logger.Info(args...)
+	}
+}
+
+func (l *namedLogger) Infof(format string, args ...interface{}) {
+	if l.Enabled(InfoLevel) {
+		l.Logger.Infof(format, args...)
+	}
+}
+
+func (l *namedLogger) Warn(args ...interface{}) {
+	if l.Enabled(WarnLevel) {
+		l.Logger.Warn(args...)
+	}
+}
+
+func (l *namedLogger) Warnf(format string, args ...interface{}) {
+	if l.Enabled(WarnLevel) {
+		l.Logger.Warnf(format, args...)
+	}
+}
+
+func (l *namedLogger) Error(args ...interface{}) {
+	if l.Enabled(ErrorLevel) {
+		l.Logger.Error(args...)
+	}
+}
+
+func (l *namedLogger) Errorf(format string, args ...interface{}) {
+	if l.Enabled(ErrorLevel) {
+		l.Logger.Errorf(format, args...)
+	}
+}
+
+func (l *namedLogger) Panic(args ...interface{}) {
+	l.Logger.Panic(args...)
+}
+
+func (l *namedLogger) Panicf(format string, args ...interface{}) {
+	l.Logger.Panicf(format, args...)
+}
+
+func (l *namedLogger) Fatal(args ...interface{}) {
+	l.Logger.Fatal(args...)
+}
+
+func (l *namedLogger) Fatalf(format string, args ...interface{}) {
+	l.Logger.Fatalf(format, args...)
+}
+
+func (l *namedLogger) WithField(key string, value interface{}) Logger {
+	return &namedLogger{Logger: l.Logger.WithField(key, value), registry: l.registry, name: l.name}
+}
+
+func (l *namedLogger) WithFields(keyValues LogFields) Logger {
+	if keyValues == nil {
+		return l
+	}
+	return &namedLogger{Logger: l.Logger.WithFields(keyValues), registry: l.registry, name: l.name}
+}
+
+func (l *namedLogger) WithError(err error) Logger {
+	return &namedLogger{Logger: l.Logger.WithError(err), registry: l.registry, name: l.name}
+}
+
+func (l *namedLogger) AddCallerSkip(skip int) Logger {
+	return &namedLogger{Logger: SkipCaller(l.Logger, skip), registry: l.registry, name: l.name}
+}
+
+func (l *namedLogger) Enabled(level Level) bool {
+	if l.registry != nil && !l.registry.Enabled(l.name, level) {
+		return false
+	}
+	return enabled(l.Logger, level)
+}
+
+// Level returns the higher of the registry's level for this logger and the wrapped logger's level
+func (l *namedLogger) Level() Level {
+	level := levelOf(l.Logger)
+	if l.registry != nil {
+		if min := l.registry.Level(l.name); min > level {
+			level = min
+		}
+	}
+	return level
+}
+
+func (l *namedLogger) Sync() error {
+	return syncLogger(l.Logger)
+}
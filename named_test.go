@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+func TestLevelRegistry(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage.*", bark.DebugLevel)
+	r.SetLevel("storage.cache", bark.ErrorLevel)
+	r.SetLevel("rpc", bark.WarnLevel)
+
+	tests := []struct {
+		name  string
+		level bark.Level
+	}{
+		{"", bark.InfoLevel},
+		{"http", bark.InfoLevel},
+		{"storage", bark.DebugLevel},
+		{"storage.disk", bark.DebugLevel},
+		{"storage.cache", bark.ErrorLevel},
+		{"storage.cache.lru", bark.ErrorLevel},
+		{"storagex", bark.InfoLevel},
+		{"rpc.client", bark.WarnLevel},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.level, r.Level(tt.name), "Unexpected level for %q", tt.name)
+	}
+
+	assert.True(t, r.Enabled("storage", bark.DebugLevel))
+	assert.False(t, r.Enabled("http", bark.DebugLevel))
+
+	r.UnsetLevel("storage.cache.*")
+	assert.Equal(t, bark.DebugLevel, r.Level("storage.cache"), "Expected the shorter prefix after unsetting")
+
+	r.SetLevel("*", bark.ErrorLevel)
+	assert.Equal(t, bark.ErrorLevel, r.Level("http"))
+	r.SetLevel("", bark.WarnLevel)
+	assert.Equal(t, bark.WarnLevel, r.Level("http"))
+	r.UnsetLevel("")
+	assert.Equal(t, bark.WarnLevel, r.Level("http"), "The root level can't be unset")
+}
+
+func TestLevelRegistrySetLevelFor(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage", bark.WarnLevel)
+
+	r.SetLevelFor("storage", bark.DebugLevel, 20*time.Millisecond)
+	assert.Equal(t, bark.DebugLevel, r.Level("storage"))
+	assert.Eventually(t, func() bool { return r.Level("storage") == bark.WarnLevel }, time.Second, 5*time.Millisecond,
+		"Expected the previous level to be restored")
+
+	r.SetLevelFor("storage", bark.DebugLevel, 20*time.Millisecond)
+	r.SetLevel("storage", bark.ErrorLevel)
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, bark.ErrorLevel, r.Level("storage"), "Expected later changes not to be reverted")
+}
+
+func TestLevelRegistrySetLevelForExtended(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage", bark.WarnLevel)
+
+	r.SetLevelFor("storage", bark.DebugLevel, 20*time.Millisecond)
+	r.SetLevelFor("storage", bark.DebugLevel, 40*time.Millisecond)
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, bark.DebugLevel, r.Level("storage"), "Expected the temporary level to be extended")
+	assert.Eventually(t, func() bool { return r.Level("storage") == bark.WarnLevel }, time.Second, 5*time.Millisecond,
+		"Expected the level from before the first temporary level to be restored")
+
+	r.SetLevelFor("cache", bark.DebugLevel, 20*time.Millisecond)
+	r.SetLevelFor("cache", bark.ErrorLevel, 20*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_, ok := r.Levels()["cache"]
+		return !ok
+	}, time.Second, 5*time.Millisecond, "Expected prefixes without a level to be unset again")
+	assert.Equal(t, bark.InfoLevel, r.Level("cache"))
+
+	r.SetLevelFor("*", bark.DebugLevel, 20*time.Millisecond)
+	r.SetLevel("*", bark.WarnLevel)
+	r.SetLevelFor("*", bark.ErrorLevel, 20*time.Millisecond)
+	assert.Eventually(t, func() bool { return r.Level("") == bark.WarnLevel }, time.Second, 5*time.Millisecond,
+		"Expected SetLevel to reset the level to restore")
+}
+
+func TestLevelRegistryConcurrency(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				r.SetLevel("storage", bark.DebugLevel)
+				r.UnsetLevel("storage")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				r.Enabled("storage.cache", bark.DebugLevel)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNamedLogger(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage.*", bark.DebugLevel)
+
+	observed, logs := barktest.New(bark.DebugLevel)
+	root := bark.NewNamedLogger(observed, r)
+	storage := root.(bark.NamedLogger).Named("storage")
+	cache := storage.(bark.NamedLogger).Named("cache")
+
+	root.Debug("root")
+	root.Info("root")
+	storage.Debugf("storage %d", 1)
+	cache.WithField("foo", "bar").Debug("cache")
+
+	assert.Equal(t, []string{"root", "storage 1", "cache"}, messages(logs))
+	entries := logs.All()
+	assert.Nil(t, entries[0].Fields)
+	assert.Equal(t, bark.Fields{bark.LoggerNameKey: "storage"}, entries[1].Fields)
+	assert.Equal(t, bark.Fields{bark.LoggerNameKey: "storage.cache", "foo": "bar"}, entries[2].Fields)
+
+	// Changes to the registry take effect immediately
+	r.SetLevel("storage.cache", bark.ErrorLevel)
+	cache.Warn("dropped")
+	storage.Warn("kept")
+	assert.Equal(t, 0, logs.FilterMessage("dropped").Len())
+	assert.Equal(t, 1, logs.FilterMessage("kept").Len())
+
+	assert.Equal(t, cache, cache.(bark.NamedLogger).Named(""), "Expected empty names to be a no-op")
+}
+
+func TestNamedLoggerWithoutNamedBackend(t *testing.T) {
+	observed, logs := barktest.New(bark.DebugLevel)
+	logger := bark.NewNamedLogger(plainLogger{observed}, nil)
+	logger = logger.(bark.NamedLogger).Named("storage").WithFields(bark.Fields{"foo": "bar"})
+	logger = logger.WithError(assert.AnError).(bark.NamedLogger).Named("cache")
+
+	logger.Debug("hello")
+	entries := logs.All()
+	require.Equal(t, 1, len(entries), "Expected a nil registry to enable every level")
+	assert.Equal(t, "storage.cache", entries[0].Fields[bark.LoggerNameKey])
+	assert.Equal(t, "bar", entries[0].Fields["foo"])
+}
+
+func TestNamedLoggerLevels(t *testing.T) {
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("noisy", bark.ErrorLevel)
+
+	observed, logs := barktest.New(bark.WarnLevel)
+	root := bark.NewNamedLogger(observed, r)
+	noisy := root.(bark.NamedLogger).Named("noisy")
+
+	assert.Equal(t, bark.WarnLevel, root.(bark.LevelEnabler).Level(), "Expected the wrapped logger's higher level")
+	assert.Equal(t, bark.ErrorLevel, noisy.(bark.LevelEnabler).Level(), "Expected the registry's higher level")
+	assert.False(t, root.(bark.LevelEnabler).Enabled(bark.InfoLevel))
+
+	assert.Panics(t, func() { noisy.Panic("oh no") })
+	assert.Equal(t, 1, logs.FilterLevel(bark.PanicLevel).Len(), "Expected panic entries to always be written")
+	assert.NoError(t, noisy.(bark.Syncer).Sync())
+}
+
+func TestNamedLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return bark.NewNamedLogger(logger, bark.NewLevelRegistry(bark.DebugLevel))
+	})
+}
+
+func TestNamedLoggerSkipsCaller(t *testing.T) {
+	assertSkipsCaller(t, func(l bark.Logger) bark.Logger {
+		return bark.NewNamedLogger(l, bark.NewLevelRegistry(bark.DebugLevel)).(bark.NamedLogger).Named("child")
+	})
+
+	observer, logs := barktest.New(bark.DebugLevel)
+	assertAddCallerSkip(t, bark.NewNamedLogger(observer, nil), logs)
+}
+
+func TestLevelRegistryRestoresBackend(t *testing.T) {
+	logrusLogger, buf := getLogrusLogger()
+	logrusLogger.SetLevel(logrus.InfoLevel)
+	plain := bark.NewLoggerFromLogrus(logrusLogger)
+
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	bark.NewNamedLogger(plain, r)
+
+	r.SetLevelFor("storage", bark.DebugLevel, 20*time.Millisecond)
+	assert.Equal(t, logrus.DebugLevel, logrusLogger.GetLevel(), "Expected the logrus level to be lowered")
+	assert.Eventually(t, func() bool {
+		return logrusLogger.GetLevel() == logrus.InfoLevel
+	}, time.Second, time.Millisecond, "Expected the logrus level to be restored after the TTL")
+
+	r.SetLevel("storage", bark.DebugLevel)
+	r.SetLevel("rpc", bark.WarnLevel)
+	assert.Equal(t, logrus.DebugLevel, logrusLogger.GetLevel())
+	r.UnsetLevel("storage")
+	assert.Equal(t, logrus.InfoLevel, logrusLogger.GetLevel(), "Expected the logrus level to be restored after UnsetLevel")
+
+	r.SetLevel("", bark.ErrorLevel)
+	assert.Equal(t, logrus.InfoLevel, logrusLogger.GetLevel(), "Expected the logrus level never to rise above its original level")
+
+	plain.Debug("plain debug")
+	assert.NotContains(t, buf.String(), "plain debug", "Expected other users of the logrus logger not to write debug entries")
+}
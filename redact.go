@@ -0,0 +1,404 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultMask replaces redacted values when no other replacement is configured.
+const DefaultMask = "[REDACTED]"
+
+// Secret is a string that must never be logged, such as a password or token. Redacting loggers
+// created by NewRedactingLogger always redact Secret fields, and Secret formats itself as
+// DefaultMask so that it is hidden from other loggers too.
+type Secret string
+
+// String implements fmt.Stringer, hiding the secret.
+func (Secret) String() string { return DefaultMask }
+
+// GoString implements fmt.GoStringer, hiding the secret.
+func (Secret) GoString() string { return DefaultMask }
+
+// MarshalText implements encoding.TextMarshaler, hiding the secret from JSON and text encoders.
+func (Secret) MarshalText() ([]byte, error) { return []byte(DefaultMask), nil }
+
+// RedactStrategy replaces a sensitive value, given as a string, with a redacted one.
+// It returns false to drop the value entirely: fields are removed, and matches within
+// strings and messages are deleted.
+type RedactStrategy func(value string) (redacted string, keep bool)
+
+// DropStrategy drops redacted values entirely.
+func DropStrategy() RedactStrategy {
+	return func(string) (string, bool) { return "", false }
+}
+
+// MaskStrategy replaces redacted values with mask, or with DefaultMask if mask is empty.
+func MaskStrategy(mask string) RedactStrategy {
+	if mask == "" {
+		mask = DefaultMask
+	}
+	return func(string) (string, bool) { return mask, true }
+}
+
+// HashStrategy replaces redacted values with a salted SHA-256 hash, as in "sha256:4c9a2e0f5b6d8e13",
+// so equal values can still be correlated across entries without being revealed.
+func HashStrategy(salt []byte) RedactStrategy {
+	return func(value string) (string, bool) {
+		h := sha256.New()
+		h.Write(salt)
+		h.Write([]byte(value))
+		return "sha256:" + hex.EncodeToString(h.Sum(nil)[:8]), true
+	}
+}
+
+// RedactOption configures a logger created by NewRedactingLogger.
+type RedactOption func(*redactor)
+
+// RedactKeys redacts the values of fields whose keys match any of the patterns, which are
+// either exact keys or globs using the syntax of path.Match (for example "*token*").
+// Non-string values are formatted with fmt.Sprint before being passed to strategy.
+func RedactKeys(strategy RedactStrategy, patterns ...string) RedactOption {
+	return func(r *redactor) {
+		for _, p := range patterns {
+			r.keys = append(r.keys, keyRule{pattern: p, strategy: strategy})
+		}
+	}
+}
+
+// RedactTypes redacts the values of fields with the same dynamic type as any of the samples.
+// For instance, RedactTypes(HashStrategy(salt), Secret("")) hashes secrets rather than masking them.
+func RedactTypes(strategy RedactStrategy, samples ...interface{}) RedactOption {
+	return func(r *redactor) {
+		for _, s := range samples {
+			r.types = append(r.types, typeRule{typ: reflect.TypeOf(s), strategy: strategy})
+		}
+	}
+}
+
+// RedactPattern redacts every match of re within messages, string fields and errors.
+func RedactPattern(strategy RedactStrategy, re *regexp.Regexp) RedactOption {
+	return func(r *redactor) {
+		r.patterns = append(r.patterns, patternRule{re: re, strategy: strategy})
+	}
+}
+
+// NewRedactingLogger wraps a logger so that sensitive values are redacted before they reach it,
+// from fields added with WithField, WithFields and WithError, and from messages. Rules are applied
+// in order: the first key rule matching a field's key wins, then the first type rule matching its
+// value, then every pattern rule is applied to string values. Secret values are masked unless a
+// type rule for Secret is given.
+//
+// Fields already attached to logger are redacted in the output of Fields, but not in its entries.
+// If logger implements CallerSkipper, so does the returned logger, and entries are attributed to
+// its callers.
+func NewRedactingLogger(logger Logger, opts ...RedactOption) Logger {
+	r := &redactor{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return &redactingLogger{Logger: SkipCaller(logger, 1), redactor: r}
+}
+
+type keyRule struct {
+	pattern  string
+	strategy RedactStrategy
+}
+
+type typeRule struct {
+	typ      reflect.Type
+	strategy RedactStrategy
+}
+
+type patternRule struct {
+	re       *regexp.Regexp
+	strategy RedactStrategy
+}
+
+type redactor struct {
+	keys     []keyRule
+	types    []typeRule
+	patterns []patternRule
+}
+
+var secretType = reflect.TypeOf(Secret(""))
+
+// redact returns the value to log for a field, and false if the field should be dropped
+func (r *redactor) redact(key string, value interface{}) (interface{}, bool) {
+	for _, rule := range r.keys {
+		if matched, err := path.Match(rule.pattern, key); rule.pattern == key || (err == nil && matched) {
+			return apply(rule.strategy, value)
+		}
+	}
+
+	typ := reflect.TypeOf(value)
+	for _, rule := range r.types {
+		if typ == rule.typ {
+			return apply(rule.strategy, value)
+		}
+	}
+	if typ == secretType {
+		return apply(MaskStrategy(""), value)
+	}
+
+	switch v := value.(type) {
+	case string:
+		if s, changed := r.redactString(v); changed {
+			return s, true
+		}
+	case error:
+		if s, changed := r.redactString(v.Error()); changed {
+			return errors.New(s), true
+		}
+	}
+	return value, true
+}
+
+// redactString applies every pattern rule to s, reporting whether anything matched
+func (r *redactor) redactString(s string) (string, bool) {
+	changed := false
+	for _, rule := range r.patterns {
+		s = rule.re.ReplaceAllStringFunc(s, func(match string) string {
+			changed = true
+			out, _ := rule.strategy(match) // dropped matches are replaced by ""
+			return out
+		})
+	}
+	return s, changed
+}
+
+func (r *redactor) redactFields(fields map[string]interface{}) Fields {
+	out := make(Fields, len(fields))
+	for k, v := range fields {
+		if v, ok := r.redact(k, v); ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func apply(strategy RedactStrategy, value interface{}) (interface{}, bool) {
+	var s string
+	if secret, ok := value.(Secret); ok {
+		s = string(secret)
+	} else {
+		s = fmt.Sprint(value)
+	}
+
+	out, keep := strategy(s)
+	if !keep {
+		return nil, false
+	}
+	return out, true
+}
+
+type redactingLogger struct {
+	Logger
+
+	redactor *redactor
+
+	// keys holds the keys of fields added through the redacting logger, which were redacted
+	// when they were added. It's copied on write, never modified in place.
+	keys map[string]struct{}
+}
+
+func (l *redactingLogger) Debug(args ...interface{}) {
+	if l.Enabled(DebugLevel) {
+		l.Logger.Debug(l.message(args)...)
+	}
+}
+
+func (l *redactingLogger) Debugf(format string, args ...interface{}) {
+	if len(l.redactor.patterns) == 0 {
+		l.Logger.Debugf(format, args...)
+	} else if l.Enabled(DebugLevel) {
+		l.Logger.Debug(l.messagef(format, args))
+	}
+}
+
+func (l *redactingLogger) Info(args ...interface{}) {
+	if l.Enabled(InfoLevel) {
+		l.Logger.Info(l.message(args)...)
+	}
+}
+
+func (l *redactingLogger) Infof(format string, args ...interface{}) {
+	if len(l.redactor.patterns) == 0 {
+		l.Logger.Infof(format, args...)
+	} else if l.Enabled(InfoLevel) {
+		l.Logger.Info(l.messagef(format, args))
+	}
+}
+
+func (l *redactingLogger) Warn(args ...interface{}) {
+	if l.Enabled(WarnLevel) {
+		l.Logger.Warn(l.message(args)...)
+	}
+}
+
+func (l *redactingLogger) Warnf(format string, args ...interface{}) {
+	if len(l.redactor.patterns) == 0 {
+		l.Logger.Warnf(format, args...)
+	} else if l.Enabled(WarnLevel) {
+		l.Logger.Warn(l.messagef(format, args))
+	}
+}
+
+func (l *redactingLogger) Error(args ...interface{}) {
+	if l.Enabled(ErrorLevel) {
+		l.Logger.Error(l.message(args)...)
+	}
+}
+
+func (l *redactingLogger) Errorf(format string, args ...interface{}) {
+	if len(l.redactor.patterns) == 0 {
+		l.Logger.Errorf(format, args...)
+	} else if l.Enabled(ErrorLevel) {
+		l.Logger.Error(l.messagef(format, args))
+	}
+}
+
+func (l *redactingLogger) Fatal(args ...interface{}) {
+	l.Logger.Fatal(l.message(args)...)
+}
+
+func (l *redactingLogger) Fatalf(format string, args ...interface{}) {
+	if len(l.redactor.patterns) == 0 {
+		l.Logger.Fatalf(format, args...)
+	} else {
+		l.Logger.Fatal(l.messagef(format, args))
+	}
+}
+
+func (l *redactingLogger) Panic(args ...interface{}) {
+	l.Logger.Panic(l.message(args)...)
+}
+
+func (l *redactingLogger) Panicf(format string, args ...interface{}) {
+	if len(l.redactor.patterns) == 0 {
+		l.Logger.Panicf(format, args...)
+	} else {
+		l.Logger.Panic(l.messagef(format, args))
+	}
+}
+
+func (l *redactingLogger) WithField(key string, value interface{}) Logger {
+	value, ok := l.redactor.redact(key, value)
+	if !ok {
+		return l
+	}
+	return l.derive(l.Logger.WithField(key, value), key)
+}
+
+func (l *redactingLogger) WithFields(keyValues LogFields) Logger {
+	if keyValues == nil {
+		return l
+	}
+	fields := l.redactor.redactFields(keyValues.Fields())
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	return l.derive(l.Logger.WithFields(fields), keys...)
+}
+
+func (l *redactingLogger) WithError(err error) Logger {
+	value, ok := l.redactor.redact(logrus.ErrorKey, err)
+	if !ok {
+		return l
+	}
+
+	switch v := value.(type) {
+	case error:
+		err = v
+	default:
+		err = errors.New(fmt.Sprint(v))
+	}
+	return l.derive(l.Logger.WithError(err), logrus.ErrorKey)
+}
+
+func (l *redactingLogger) AddCallerSkip(skip int) Logger {
+	return &redactingLogger{Logger: SkipCaller(l.Logger, skip), redactor: l.redactor, keys: l.keys}
+}
+
+// Fields redacts the fields that were already attached to the wrapped logger. Fields added through
+// the redacting logger were redacted when they were added, so they aren't redacted again.
+func (l *redactingLogger) Fields() Fields {
+	fields := l.Logger.Fields()
+	if fields == nil {
+		return nil
+	}
+
+	out := make(Fields, len(fields))
+	for k, v := range fields {
+		if _, ok := l.keys[k]; ok {
+			out[k] = v
+		} else if v, ok := l.redactor.redact(k, v); ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// derive returns a redacting logger wrapping logger, which has had the given redacted keys added
+func (l *redactingLogger) derive(logger Logger, added ...string) *redactingLogger {
+	keys := make(map[string]struct{}, len(l.keys)+len(added))
+	for k := range l.keys {
+		keys[k] = struct{}{}
+	}
+	for _, k := range added {
+		keys[k] = struct{}{}
+	}
+	return &redactingLogger{Logger: logger, redactor: l.redactor, keys: keys}
+}
+
+func (l *redactingLogger) Enabled(level Level) bool {
+	return enabled(l.Logger, level)
+}
+
+func (l *redactingLogger) Level() Level {
+	return levelOf(l.Logger)
+}
+
+func (l *redactingLogger) Sync() error {
+	return syncLogger(l.Logger)
+}
+
+// message redacts the arguments of a logging call, formatting them only if patterns are configured
+func (l *redactingLogger) message(args []interface{}) []interface{} {
+	if len(l.redactor.patterns) == 0 {
+		return args
+	}
+	msg, _ := l.redactor.redactString(fmt.Sprint(args...))
+	return []interface{}{msg}
+}
+
+// messagef formats and redacts the arguments of a logging call; it's only called if patterns are configured
+func (l *redactingLogger) messagef(format string, args []interface{}) string {
+	msg, _ := l.redactor.redactString(fmt.Sprintf(format, args...))
+	return msg
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+var emailPattern = regexp.MustCompile(`[\w.+-]+@[\w-]+\.[\w.]+`)
+
+func TestRedactKeys(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewRedactingLogger(logger,
+		bark.RedactKeys(bark.MaskStrategy(""), "password", "*token*"),
+		bark.RedactKeys(bark.DropStrategy(), "ssn"),
+		bark.RedactKeys(bark.MaskStrategy("***"), "*"), // shadowed by the earlier rules
+	)
+
+	logger.WithField("password", "hunter2").
+		WithFields(bark.Fields{"access_token": "abc", "ssn": 123456789}).
+		WithField("user", "alice").
+		Info("login")
+
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, bark.Fields{
+		"password":     "[REDACTED]",
+		"access_token": "[REDACTED]",
+		"user":         "***",
+	}, logs.All()[0].Fields)
+}
+
+func TestRedactTypes(t *testing.T) {
+	type creditCard struct{ Number string }
+	salt := []byte("pepper")
+
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewRedactingLogger(logger, bark.RedactTypes(bark.HashStrategy(salt), creditCard{}))
+
+	logger.WithFields(bark.Fields{
+		"card":   creditCard{"4111111111111111"},
+		"token":  bark.Secret("abc"),
+		"amount": 42,
+	}).Info("charge")
+
+	fields := logs.All()[0].Fields
+	assert.Equal(t, "[REDACTED]", fields["token"], "Secrets should be masked by default")
+	assert.Equal(t, 42, fields["amount"])
+
+	hashed, _ := bark.HashStrategy(salt)("{4111111111111111}")
+	assert.Equal(t, hashed, fields["card"])
+	assert.True(t, strings.HasPrefix(hashed, "sha256:"), "Unexpected hash %q", hashed)
+
+	other, _ := bark.HashStrategy([]byte("salt"))("{4111111111111111}")
+	assert.NotEqual(t, hashed, other, "Hashes should depend on the salt")
+}
+
+func TestRedactSecretStrategy(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewRedactingLogger(logger, bark.RedactTypes(bark.DropStrategy(), bark.Secret("")))
+	logger.WithField("token", bark.Secret("abc")).Info("hello")
+	assert.Empty(t, logs.All()[0].Fields, "Expected secret to be dropped")
+}
+
+func TestSecretFormatting(t *testing.T) {
+	secret := bark.Secret("hunter2")
+	assert.Equal(t, "[REDACTED]", fmt.Sprint(secret))
+	assert.Equal(t, "[REDACTED] [REDACTED] [REDACTED]", fmt.Sprintf("%v %s %#v", secret, secret, secret))
+
+	out, err := json.Marshal(map[string]interface{}{"password": secret})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"password":"[REDACTED]"}`, string(out))
+
+	// Even plain loggers don't reveal secrets
+	logger, buffer := getBarkLogger()
+	logger.WithField("password", secret).Infof("password is %v", secret)
+	assert.NotContains(t, buffer.String(), "hunter2")
+}
+
+func TestRedactPattern(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewRedactingLogger(logger, bark.RedactPattern(bark.MaskStrategy("<email>"), emailPattern))
+
+	l := logger.WithFields(bark.Fields{"to": "alice@example.com", "count": 2}).
+		WithError(errors.New("no mailbox for bob@example.com"))
+	l.Info("sending to ", "alice@example.com")
+	l.Warnf("bounced from %s", "bob@example.com")
+
+	entries := logs.TakeAll()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "sending to <email>", entries[0].Message)
+	assert.Equal(t, "bounced from <email>", entries[1].Message)
+	assert.Equal(t, bark.Fields{
+		"to":    "<email>",
+		"count": 2,
+		"error": errors.New("no mailbox for <email>"),
+	}, entries[0].Fields)
+	assert.EqualError(t, entries[0].Error, "no mailbox for <email>")
+}
+
+func TestRedactPatternDrop(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewRedactingLogger(logger, bark.RedactPattern(bark.DropStrategy(), emailPattern))
+	logger.WithField("note", "ask alice@example.com").Errorf("contact %s now", "alice@example.com")
+
+	entry := logs.All()[0]
+	assert.Equal(t, "contact  now", entry.Message)
+	assert.Equal(t, "ask ", entry.Fields["note"])
+}
+
+func TestRedactWithError(t *testing.T) {
+	err := errors.New("great sadness")
+
+	logger, logs := barktest.New(bark.DebugLevel)
+	bark.NewRedactingLogger(logger).WithError(err).Info("unredacted")
+	bark.NewRedactingLogger(logger, bark.RedactKeys(bark.DropStrategy(), "error")).WithError(err).Info("dropped")
+	bark.NewRedactingLogger(logger, bark.RedactKeys(bark.MaskStrategy(""), "error")).WithError(err).Info("masked")
+
+	entries := logs.TakeAll()
+	require.Len(t, entries, 3)
+	assert.Equal(t, err, entries[0].Error, "Errors without sensitive data should be unchanged")
+	assert.Nil(t, entries[1].Error, "Expected error to be dropped")
+	assert.EqualError(t, entries[2].Error, "[REDACTED]")
+}
+
+func TestRedactFieldsOutput(t *testing.T) {
+	salt := []byte("pepper")
+	hashed, _ := bark.HashStrategy(salt)("hunter2")
+
+	logger, _ := barktest.New(bark.DebugLevel)
+	inner := logger.WithFields(bark.Fields{"password": "hunter2", "user": "alice"})
+	l := bark.NewRedactingLogger(inner, bark.RedactKeys(bark.HashStrategy(salt), "password"))
+
+	// Fields already on the wrapped logger are redacted in the output
+	assert.Equal(t, bark.Fields{"password": hashed, "user": "alice"}, l.Fields())
+
+	// Fields added through the redacting logger aren't hashed twice, even if they replace fields
+	// already on the wrapped logger
+	l = bark.NewRedactingLogger(logger, bark.RedactKeys(bark.HashStrategy(salt), "password")).WithField("password", "hunter2")
+	assert.Equal(t, bark.Fields{"password": hashed}, l.Fields())
+	l = bark.NewRedactingLogger(inner, bark.RedactKeys(bark.HashStrategy(salt), "password")).
+		WithFields(bark.Fields{"password": "hunter2"})
+	assert.Equal(t, bark.Fields{"password": hashed, "user": "alice"}, l.Fields())
+	for _, v := range l.Fields() {
+		assert.IsType(t, "", v, "Expected redacted values to be plain strings")
+	}
+
+	assert.Nil(t, bark.NewRedactingLogger(logger).Fields())
+}
+
+// Counts the times it's formatted
+type countingStringer struct{ calls int }
+
+func (s *countingStringer) String() string {
+	s.calls++
+	return "alice@example.com"
+}
+
+// Records the format strings passed to Infof
+type formatRecorder struct {
+	bark.Logger
+	formats []string
+}
+
+func (r *formatRecorder) Infof(format string, args ...interface{}) {
+	r.formats = append(r.formats, format)
+	r.Logger.Infof(format, args...)
+}
+
+func TestRedactingLoggerFormatsLazily(t *testing.T) {
+	logger, logs := barktest.New(bark.WarnLevel)
+	l := bark.NewRedactingLogger(logger, bark.RedactPattern(bark.MaskStrategy("<email>"), emailPattern))
+
+	arg := &countingStringer{}
+	l.Debugf("hello %v", arg)
+	l.Info("hello ", arg)
+	assert.Equal(t, 0, arg.calls, "Expected disabled entries not to be formatted")
+
+	l.Warnf("hello %v", arg)
+	assert.Equal(t, 1, arg.calls)
+	assert.Equal(t, []string{"hello <email>"}, messages(logs))
+
+	// Without patterns, f-variant calls are passed through unchanged
+	recorder := &formatRecorder{Logger: logger}
+	bark.NewRedactingLogger(recorder).Infof("hello %v", arg)
+	assert.Equal(t, []string{"hello %v"}, recorder.formats)
+}
+
+func TestRedactingLoggerForwardsLevels(t *testing.T) {
+	logger, _ := barktest.New(bark.WarnLevel)
+	l := bark.NewRedactingLogger(logger).WithField("foo", "bar")
+
+	levels, ok := l.(bark.LevelEnabler)
+	require.True(t, ok, "Redacting logger should implement LevelEnabler")
+	assert.Equal(t, bark.WarnLevel, levels.Level())
+	assert.NoError(t, l.(bark.Syncer).Sync())
+}
+
+func TestRedactingLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return bark.NewRedactingLogger(logger, bark.RedactKeys(bark.MaskStrategy(""), "password"))
+	})
+}
+
+func TestRedactingLoggerSkipsCaller(t *testing.T) {
+	assertSkipsCaller(t, func(l bark.Logger) bark.Logger {
+		return bark.NewRedactingLogger(l).WithField("foo", "bar")
+	})
+
+	observer, logs := barktest.New(bark.DebugLevel)
+	assertAddCallerSkip(t, bark.NewRedactingLogger(observer), logs)
+}
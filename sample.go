@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// Debug through Error entries are sampled; Panic and Fatal entries never are.
+	_numSampledLevels = int(ErrorLevel-DebugLevel) + 1
+	_countersPerLevel = 4096
+)
+
+// SamplerOption configures a logger created by NewSamplingLogger.
+type SamplerOption func(*sampler)
+
+// SamplerStats reports each dropped entry by incrementing a counter with the given name,
+// tagged with the entry's level.
+func SamplerStats(reporter StatsReporter, name string) SamplerOption {
+	return func(s *sampler) {
+		s.reporter = reporter
+		s.statName = name
+	}
+}
+
+// SamplerHook calls hook with the level and message (or format string) of every entry
+// considered for sampling, and whether it was kept.
+func SamplerHook(hook func(level Level, msg string, kept bool)) SamplerOption {
+	return func(s *sampler) {
+		s.hook = hook
+	}
+}
+
+// NewSamplingLogger wraps a logger to cap the rate of repetitive entries, in the manner of zap's
+// sampler. Entries are keyed by level and message (or, for the f-variant methods, by format string,
+// so entries from one template share a key regardless of their arguments). Within each tick, the
+// first entries for a key are logged, then every thereafter-th entry; the rest are dropped.
+// If thereafter is zero, every entry after the first is dropped until the next tick.
+//
+// Keys are hashed into a fixed number of counters, so memory use is bounded but distinct keys
+// may occasionally share a counter. Loggers derived with WithField and friends share counters
+// with their parent. Panic and Fatal entries are never dropped. If logger implements CallerSkipper,
+// so does the returned logger, and entries are attributed to its callers.
+func NewSamplingLogger(logger Logger, tick time.Duration, first, thereafter int, opts ...SamplerOption) Logger {
+	s := &sampler{
+		tick:       tick,
+		first:      uint64(first),
+		thereafter: uint64(thereafter),
+		counts:     new([_numSampledLevels][_countersPerLevel]counter),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return &samplingLogger{Logger: SkipCaller(logger, 1), sampler: s}
+}
+
+type counter struct {
+	resetAt int64
+	count   uint64
+}
+
+// incCheckReset increments the counter, first resetting it if its tick has elapsed
+func (c *counter) incCheckReset(t time.Time, tick time.Duration) uint64 {
+	tn := t.UnixNano()
+	resetAfter := atomic.LoadInt64(&c.resetAt)
+	if resetAfter > tn {
+		return atomic.AddUint64(&c.count, 1)
+	}
+
+	atomic.StoreUint64(&c.count, 1)
+	if !atomic.CompareAndSwapInt64(&c.resetAt, resetAfter, tn+tick.Nanoseconds()) {
+		// We raced with another goroutine trying to reset, and it also reset
+		// the counter to 1, so we need to reincrement the counter.
+		return atomic.AddUint64(&c.count, 1)
+	}
+	return 1
+}
+
+type sampler struct {
+	tick              time.Duration
+	first, thereafter uint64
+	counts            *[_numSampledLevels][_countersPerLevel]counter
+
+	reporter StatsReporter
+	statName string
+	hook     func(Level, string, bool)
+}
+
+// keep counts an entry, reporting whether it should be logged
+func (s *sampler) keep(level Level, msg string) bool {
+	h := fnv.New32a()
+	h.Write([]byte(msg))
+	c := &s.counts[level-DebugLevel][h.Sum32()%_countersPerLevel]
+
+	n := c.incCheckReset(time.Now(), s.tick)
+	kept := n <= s.first || (s.thereafter > 0 && (n-s.first)%s.thereafter == 0)
+
+	if s.hook != nil {
+		s.hook(level, msg, kept)
+	}
+	if !kept && s.reporter != nil {
+		s.reporter.IncCounter(s.statName, Tags{"level": level.String()}, 1)
+	}
+	return kept
+}
+
+type samplingLogger struct {
+	Logger
+
+	sampler *sampler
+}
+
+func (l *samplingLogger) Debug(args ...interface{}) {
+	if l.Enabled(DebugLevel) && l.sampler.keep(DebugLevel, fmt.Sprint(args...)) {
+		l.Logger.Debug(args...)
+	}
+}
+
+func (l *samplingLogger) Debugf(format string, args ...interface{}) {
+	if l.Enabled(DebugLevel) && l.sampler.keep(DebugLevel, format) {
+		l.Logger.Debugf(format, args...)
+	}
+}
+
+func (l *samplingLogger) Info(args ...interface{}) {
+	if l.Enabled(InfoLevel) && l.sampler.keep(InfoLevel, fmt.Sprint(args...)) {
+		l.Logger.Info(args...)
+	}
+}
+
+func (l *samplingLogger) Infof(format string, args ...interface{}) {
+	if l.Enabled(InfoLevel) && l.sampler.keep(InfoLevel, format) {
+		l.Logger.Infof(format, args...)
+	}
+}
+
+func (l *samplingLogger) Warn(args ...interface{}) {
+	if l.Enabled(WarnLevel) && l.sampler.keep(WarnLevel, fmt.Sprint(args...)) {
+		l.Logger.Warn(args...)
+	}
+}
+
+func (l *samplingLogger) Warnf(format string, args ...interface{}) {
+	if l.Enabled(WarnLevel) && l.sampler.keep(WarnLevel, format) {
+		l.Logger.Warnf(format, args...)
+	}
+}
+
+func (l *samplingLogger) Error(args ...interface{}) {
+	if l.Enabled(ErrorLevel) && l.sampler.keep(ErrorLevel, fmt.Sprint(args...)) {
+		l.Logger.Error(args...)
+	}
+}
+
+func (l *samplingLogger) Errorf(format string, args ...interface{}) {
+	if l.Enabled(ErrorLevel) && l.sampler.keep(ErrorLevel, format) {
+		l.Logger.Errorf(format, args...)
+	}
+}
+
+func (l *samplingLogger) Panic(args ...interface{}) {
+	l.Logger.Panic(args...)
+}
+
+func (l *samplingLogger) Panicf(format string, args ...interface{}) {
+	l.Logger.Panicf(format, args...)
+}
+
+func (l *samplingLogger) Fatal(args ...interface{}) {
+	l.Logger.Fatal(args...)
+}
+
+func (l *samplingLogger) Fatalf(format string, args ...interface{}) {
+	l.Logger.Fatalf(format, args...)
+}
+
+func (l *samplingLogger) WithField(key string, value interface{}) Logger {
+	return &samplingLogger{Logger: l.Logger.WithField(key, value), sampler: l.sampler}
+}
+
+func (l *samplingLogger) WithFields(keyValues LogFields) Logger {
+	return &samplingLogger{Logger: l.Logger.WithFields(keyValues), sampler: l.sampler}
+}
+
+func (l *samplingLogger) WithError(err error) Logger {
+	return &samplingLogger{Logger: l.Logger.WithError(err), sampler: l.sampler}
+}
+
+func (l *samplingLogger) AddCallerSkip(skip int) Logger {
+	return &samplingLogger{Logger: SkipCaller(l.Logger, skip), sampler: l.sampler}
+}
+
+func (l *samplingLogger) Enabled(level Level) bool {
+	return enabled(l.Logger, level)
+}
+
+func (l *samplingLogger) Level() Level {
+	return levelOf(l.Logger)
+}
+
+func (l *samplingLogger) Sync() error {
+	return syncLogger(l.Logger)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+	"github.com/uber-common/bark/mocks"
+)
+
+func TestSamplingLogger(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewSamplingLogger(logger, time.Minute, 2, 3)
+
+	for i := 1; i <= 10; i++ {
+		logger.Info("hello")
+		logger.Infof("attempt %d", i)
+		logger.Warn("hello") // keyed separately from the Info entries
+	}
+
+	assert.Equal(t, 4, logs.FilterLevel(bark.InfoLevel).FilterMessage("hello").Len(),
+		"Expected the first 2 entries, then every 3rd")
+	assert.Equal(t, 4, logs.FilterLevel(bark.WarnLevel).FilterMessage("hello").Len())
+
+	var attempts []string
+	for _, e := range logs.FilterMessageSnippet("attempt").All() {
+		attempts = append(attempts, e.Message)
+	}
+	assert.Equal(t, []string{"attempt 1", "attempt 2", "attempt 5", "attempt 8"}, attempts,
+		"Expected f-variant entries to be keyed by format string")
+}
+
+func TestSamplingLoggerEveryLevel(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewSamplingLogger(logger, time.Minute, 1, 0)
+
+	methods := []func(...interface{}){logger.Debug, logger.Info, logger.Warn, logger.Error}
+	formatted := []func(string, ...interface{}){logger.Debugf, logger.Infof, logger.Warnf, logger.Errorf}
+	for i := 0; i < 3; i++ {
+		for _, m := range methods {
+			m("plain")
+		}
+		for _, m := range formatted {
+			m("formatted %d", i)
+		}
+	}
+
+	assert.Equal(t, 8, logs.Len(), "Expected one entry per level and method")
+}
+
+func TestSamplingLoggerNeverDropsPanic(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewSamplingLogger(logger, time.Minute, 1, 0)
+	for i := 0; i < 3; i++ {
+		assert.Panics(t, func() { logger.Panic("oh no") })
+		assert.Panics(t, func() { logger.Panicf("oh %s", "no") })
+	}
+	assert.Equal(t, 6, logs.Len())
+}
+
+func TestSamplingLoggerTick(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewSamplingLogger(logger, 20*time.Millisecond, 1, 0)
+
+	logger.Info("hello")
+	logger.Info("hello")
+	require.Equal(t, 1, logs.Len())
+
+	time.Sleep(40 * time.Millisecond)
+	logger.Info("hello")
+	assert.Equal(t, 2, logs.Len(), "Expected counters to reset after a tick")
+}
+
+func TestSamplingLoggerSharedCounters(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel)
+	logger = bark.NewSamplingLogger(logger, time.Minute, 1, 0)
+
+	logger.Info("hello")
+	logger.WithField("foo", "bar").Info("hello")
+	logger.WithFields(bark.Fields{"foo": "bar"}).WithError(fmt.Errorf("oh no")).Info("hello")
+	assert.Equal(t, 1, logs.Len(), "Expected derived loggers to share counters")
+}
+
+func TestSamplingLoggerDisabledLevels(t *testing.T) {
+	var considered int
+	logger, logs := barktest.New(bark.InfoLevel)
+	logger = bark.NewSamplingLogger(logger, time.Minute, 1, 0,
+		bark.SamplerHook(func(bark.Level, string, bool) { considered++ }))
+
+	logger.Debug("hello")
+	logger.Debugf("hello %d", 1)
+	assert.Equal(t, 0, considered, "Disabled entries should not be counted")
+	assert.Equal(t, 0, logs.Len())
+
+	levels, ok := logger.(bark.LevelEnabler)
+	require.True(t, ok, "Sampling logger should implement LevelEnabler")
+	assert.Equal(t, bark.InfoLevel, levels.Level())
+	assert.NoError(t, logger.(bark.Syncer).Sync())
+}
+
+func TestSamplingLoggerReporting(t *testing.T) {
+	type decision struct {
+		level bark.Level
+		msg   string
+		kept  bool
+	}
+	var decisions []decision
+
+	reporter := &mocks.StatsReporter{}
+	reporter.On("IncCounter", mock.Anything, mock.Anything, mock.Anything)
+
+	logger, _ := barktest.New(bark.DebugLevel)
+	logger = bark.NewSamplingLogger(logger, time.Minute, 1, 0,
+		bark.SamplerStats(reporter, "logs.dropped"),
+		bark.SamplerHook(func(level bark.Level, msg string, kept bool) {
+			decisions = append(decisions, decision{level, msg, kept})
+		}),
+	)
+
+	logger.Warn("hello")
+	logger.Warn("hello")
+	logger.Errorf("failed %d", 1)
+	logger.Errorf("failed %d", 2)
+	logger.Errorf("failed %d", 3)
+
+	assert.Equal(t, []decision{
+		{bark.WarnLevel, "hello", true},
+		{bark.WarnLevel, "hello", false},
+		{bark.ErrorLevel, "failed %d", true},
+		{bark.ErrorLevel, "failed %d", false},
+		{bark.ErrorLevel, "failed %d", false},
+	}, decisions)
+
+	reporter.AssertNumberOfCalls(t, "IncCounter", 3)
+	reporter.AssertCalled(t, "IncCounter", "logs.dropped", bark.Tags{"level": "warn"}, int64(1))
+	reporter.AssertCalled(t, "IncCounter", "logs.dropped", bark.Tags{"level": "error"}, int64(1))
+}
+
+func TestSamplingLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		logger, _ := getBarkLogger()
+		return bark.NewSamplingLogger(logger, time.Second, 10, 10)
+	})
+}
+
+func TestSamplingLoggerSkipsCaller(t *testing.T) {
+	assertSkipsCaller(t, func(l bark.Logger) bark.Logger {
+		return bark.NewSamplingLogger(l, time.Minute, 10, 0)
+	})
+
+	observer, logs := barktest.New(bark.DebugLevel)
+	assertAddCallerSkip(t, bark.NewSamplingLogger(observer, time.Minute, 10, 0), logs)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// CallerKey is the field holding the file and line reported by standard library loggers
+// with the Lshortfile or Llongfile flag.
+const CallerKey = "caller"
+
+// _stdLogCallerSkip is the number of stack frames between the code calling a standard library
+// logger and the bark logger: stdLogWriter.Write, log.(*Logger).output, and Print or another
+// method of log.Logger.
+const _stdLogCallerSkip = 3
+
+// NewStdLogAt returns a *log.Logger that writes each entry to logger at the given level, for
+// dependencies that only accept standard library loggers, such as http.Server's ErrorLog.
+// The returned logger has no prefix or flags, since bark loggers record their own times; if
+// they're set later, the prefix, date and time are stripped from entries, and the file and
+// line are moved to the CallerKey field. If logger implements CallerSkipper, entries are
+// attributed to the code calling the standard library logger.
+func NewStdLogAt(logger Logger, level Level) (*log.Logger, error) {
+	if level < DebugLevel || level > FatalLevel {
+		return nil, fmt.Errorf("unrecognized level: %v", level)
+	}
+
+	w := &stdLogWriter{logger: SkipCaller(logger, _stdLogCallerSkip), level: level}
+	std := log.New(w, "", 0)
+	w.std = std
+	return std, nil
+}
+
+// RedirectStdLog sends the output of the log package's standard logger to logger at info level
+// until the returned function is called. The standard logger's prefix and flags are unchanged,
+// and are parsed out of each entry as in NewStdLogAt.
+func RedirectStdLog(logger Logger) func() {
+	std := log.Default()
+	prev := std.Writer()
+	std.SetOutput(&stdLogWriter{logger: SkipCaller(logger, _stdLogCallerSkip), level: InfoLevel, std: std})
+	return func() {
+		std.SetOutput(prev)
+	}
+}
+
+// stdLogWriter writes the entries of a standard library logger to a bark.Logger
+type stdLogWriter struct {
+	logger Logger
+	level  Level
+
+	// std is the logger writing entries, whose flags and prefix are read on every write
+	// since they may be changed at any time
+	std *log.Logger
+}
+
+func (w *stdLogWriter) Write(p []byte) (int, error) {
+	msg, caller := parseStdLog(string(p), w.std.Flags(), w.std.Prefix())
+
+	logger := w.logger
+	if caller != "" {
+		logger = logger.WithField(CallerKey, caller)
+	}
+
+	switch w.level {
+	case DebugLevel:
+		logger.Debug(msg)
+	case InfoLevel:
+		logger.Info(msg)
+	case WarnLevel:
+		logger.Warn(msg)
+	case ErrorLevel:
+		logger.Error(msg)
+	case PanicLevel:
+		logger.Panic(msg)
+	case FatalLevel:
+		logger.Fatal(msg)
+	}
+	return len(p), nil
+}
+
+// parseStdLog splits an entry formatted by a standard library logger with the given flags
+// and prefix into its message and, if the flags include it, its caller
+func parseStdLog(entry string, flags int, prefix string) (msg, caller string) {
+	msg = strings.TrimSuffix(entry, "\n")
+	if flags&log.Lmsgprefix == 0 {
+		msg = strings.TrimPrefix(msg, prefix)
+	}
+
+	if flags&log.Ldate != 0 {
+		msg = skip(msg, len("2009/01/23 "))
+	}
+	if flags&log.Lmicroseconds != 0 {
+		msg = skip(msg, len("01:23:23.123123 "))
+	} else if flags&log.Ltime != 0 {
+		msg = skip(msg, len("01:23:23 "))
+	}
+
+	if flags&(log.Lshortfile|log.Llongfile) != 0 {
+		if i := strings.Index(msg, ": "); i >= 0 {
+			caller, msg = msg[:i], msg[i+2:]
+		}
+	}
+
+	if flags&log.Lmsgprefix != 0 {
+		msg = strings.TrimPrefix(msg, prefix)
+	}
+	return msg, caller
+}
+
+func skip(s string, n int) string {
+	if len(s) < n {
+		return ""
+	}
+	return s[n:]
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bark_test
+
+import (
+	"bytes"
+	"log"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
+)
+
+func TestNewStdLogAt(t *testing.T) {
+	for _, level := range []bark.Level{bark.DebugLevel, bark.InfoLevel, bark.WarnLevel, bark.ErrorLevel} {
+		logger, logs := barktest.New(bark.DebugLevel)
+		std, err := bark.NewStdLogAt(logger.WithField("foo", "bar"), level)
+		require.NoError(t, err)
+		assert.Equal(t, 0, std.Flags(), "Expected no flags by default")
+		assert.Equal(t, "", std.Prefix(), "Expected no prefix by default")
+
+		std.Print("hello")
+		std.Printf("hello %s\n", "world")
+
+		entries := logs.All()
+		require.Equal(t, 2, len(entries))
+		assert.Equal(t, level, entries[0].Level)
+		assert.Equal(t, "hello", entries[0].Message)
+		assert.Equal(t, "hello world", entries[1].Message, "Expected one trailing newline to be trimmed")
+		assert.Equal(t, bark.Fields{"foo": "bar"}, entries[1].Fields)
+	}
+}
+
+func TestNewStdLogAtTerminalLevels(t *testing.T) {
+	var codes []int
+	logger, logs := barktest.New(bark.DebugLevel, bark.WithExitFunc(func(code int) { codes = append(codes, code) }))
+
+	std, err := bark.NewStdLogAt(logger, bark.PanicLevel)
+	require.NoError(t, err)
+	assert.PanicsWithValue(t, "oh no", func() { std.Print("oh no") })
+
+	std, err = bark.NewStdLogAt(logger, bark.FatalLevel)
+	require.NoError(t, err)
+	std.Print("oh no")
+	assert.Equal(t, []int{1}, codes)
+	assert.Equal(t, 2, logs.FilterMessage("oh no").Len())
+
+	_, err = bark.NewStdLogAt(logger, bark.Level(42))
+	assert.EqualError(t, err, "unrecognized level: Level(42)")
+}
+
+func TestStdLogFlags(t *testing.T) {
+	tests := []struct {
+		flags  int
+		prefix string
+		caller string
+	}{
+		{0, "", ""},
+		{log.LstdFlags, "", ""},
+		{log.LstdFlags | log.Lmicroseconds | log.LUTC, "[app] ", ""},
+		{log.Ltime | log.Lmsgprefix, "[app] ", ""},
+		{log.Lmicroseconds | log.Lshortfile, "", "stdlog_test.go"},
+		{log.LstdFlags | log.Llongfile | log.Lmsgprefix, "app: ", "/stdlog_test.go"},
+		{log.Lshortfile, "[app] ", "stdlog_test.go"},
+	}
+
+	for _, tt := range tests {
+		logger, logs := barktest.New(bark.DebugLevel)
+		std, err := bark.NewStdLogAt(logger, bark.InfoLevel)
+		require.NoError(t, err)
+		std.SetFlags(tt.flags)
+		std.SetPrefix(tt.prefix)
+
+		std.Print("hello: world")
+
+		entries := logs.All()
+		require.Equal(t, 1, len(entries))
+		assert.Equal(t, "hello: world", entries[0].Message, "Unexpected message with flags %b and prefix %q", tt.flags, tt.prefix)
+		if tt.caller == "" {
+			assert.NotContains(t, entries[0].Fields, bark.CallerKey)
+		} else {
+			caller, ok := entries[0].Fields[bark.CallerKey].(string)
+			require.True(t, ok, "Expected a caller with flags %b", tt.flags)
+			assert.Contains(t, caller, tt.caller+":")
+		}
+	}
+}
+
+func TestRedirectStdLog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(buf)
+	flags, prefix := log.Flags(), log.Prefix()
+	defer log.SetFlags(flags)
+	defer log.SetPrefix(prefix)
+
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	log.SetPrefix("[app] ")
+
+	logger, logs := barktest.New(bark.DebugLevel)
+	restore := bark.RedirectStdLog(logger)
+	log.Print("redirected")
+	restore()
+	log.Print("restored")
+
+	entries := logs.All()
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, bark.InfoLevel, entries[0].Level)
+	assert.Equal(t, "redirected", entries[0].Message)
+	assert.Contains(t, entries[0].Fields[bark.CallerKey], "stdlog_test.go:")
+
+	assert.Equal(t, log.LstdFlags|log.Lshortfile, log.Flags(), "Expected flags to be unchanged")
+	assert.Contains(t, buf.String(), "restored", "Expected the previous output to be restored")
+	assert.NotContains(t, buf.String(), "redirected")
+}
+
+func TestStdLogCaller(t *testing.T) {
+	logger, logs := barktest.New(bark.DebugLevel, bark.WithExitFunc(func(int) {}))
+	std, err := bark.NewStdLogAt(logger, bark.InfoLevel)
+	require.NoError(t, err)
+
+	_, _, line, _ := runtime.Caller(0)
+	std.Print("print")
+	std.Printf("printf %d", 1)
+	std.Println("println")
+	require.NoError(t, std.Output(1, "output"))
+
+	restore := bark.RedirectStdLog(logger)
+	log.Print("redirected")
+	restore()
+
+	entries := logs.All()
+	require.Len(t, entries, 5)
+	for i, e := range entries[:4] {
+		assert.Equal(t, "stdlog_test.go", filepath.Base(e.Caller.File), "Expected %q to be attributed to the caller", e.Message)
+		assert.Equal(t, line+1+i, e.Caller.Line, "Unexpected caller line for %q", e.Message)
+	}
+	assert.Equal(t, line+7, entries[4].Caller.Line, "Unexpected caller line for redirected entries")
+}
@@ -43,6 +43,14 @@ func main() {
 		bark.NewLoggerFromLogrus(logrusLogger).Fatal("fatal error")
 	case "bark.Fatalf":
 		bark.NewLoggerFromLogrus(logrusLogger).Fatalf("fatal error%s", "fatal error")
+	case "multi.Fatal":
+		bark.NewMultiLogger(bark.NewLoggerFromLogrus(logrusLogger), bark.NewLoggerFromLogrus(logrusLogger)).Fatal("fatal error")
+	case "multi.Fatalf":
+		bark.NewMultiLogger(bark.NewLoggerFromLogrus(logrusLogger), bark.NewLoggerFromLogrus(logrusLogger)).Fatalf("fatal error%s", "fatal error")
+	case "async.Fatal":
+		async := bark.NewAsyncLogger(bark.NewLoggerFromLogrus(logrusLogger), 16)
+		async.Info("queued")
+		async.Fatal("fatal error")
 	}
 
 	logrus.Error("Expected fatal methods to exit...")
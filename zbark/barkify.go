@@ -19,6 +19,8 @@
 package zbark
 
 import (
+	"fmt"
+	"os"
 	"sort"
 	"time"
 
@@ -29,23 +31,60 @@ import (
 )
 
 // Barkify wraps a zap logger in a compatibility layer so that it satisfies
-// the bark.Logger interface. Note that the wrapper always returns nil from
-// the Fields method, since zap doesn't support this functionality.
-func Barkify(l *zap.Logger) bark.Logger {
+// the bark.Logger interface. Since zap can't reconstruct fields from its
+// encoded context, the wrapper keeps its own copy of the fields added through
+// WithField, WithFields and WithError to return from the Fields method; fields
+// added to the zap logger before it was wrapped aren't included.
+//
+// Fatal and Fatalf write the entry, then exit the process with os.Exit, as zap
+// does; use bark.WithExitFunc and bark.WithPreExitHook to change what happens
+// after a Fatal entry. If l was created by Zapify, the original bark.Logger is
+// returned and the options are ignored.
+//
+// The returned logger also implements bark.LevelEnabler, bark.LevelLogger,
+// bark.NamedLogger, bark.CallerSkipper and bark.Syncer.
+func Barkify(l *zap.Logger, opts ...bark.ExitOption) bark.Logger {
 	if z, ok := l.Core().(*zapper); ok {
 		return z.l
 	}
-	return barker{l.WithOptions(zap.AddCallerSkip(_barkifyCallerSkip)).Sugar()}
+	return barker{
+		SugaredLogger: l.WithOptions(zap.AddCallerSkip(_barkifyCallerSkip)).Sugar(),
+		levels:        l.Core(),
+		exit:          bark.NewExitFunc(os.Exit, opts...),
+	}
 }
 
-type barker struct{ *zap.SugaredLogger }
+// _errorKey is the field name used by WithError. It matches both zap.Error
+// and logrus.ErrorKey.
+const _errorKey = "error"
+
+type barker struct {
+	*zap.SugaredLogger
+
+	// levels reports the levels enabled on the wrapped logger's core. Adding
+	// context to a core doesn't change its levels, so this doesn't need to be
+	// updated by WithField and friends.
+	levels zapcore.LevelEnabler
+
+	// fields mirrors the context added through the bark.Logger interface so
+	// that it can be returned from Fields. It's copied on write, never
+	// modified in place.
+	fields bark.Fields
+
+	// exit runs pre-exit hooks and exits the process after Fatal entries.
+	exit func(code int)
+}
 
 func (l barker) WithField(key string, value interface{}) bark.Logger {
 	l.SugaredLogger = l.SugaredLogger.With(toZapField(key, value)) // safe to change because we pass-by-value
+	l.fields = l.withFields(bark.Fields{key: value})
 	return l
 }
 
 func (l barker) WithFields(keyValues bark.LogFields) bark.Logger {
+	if keyValues == nil {
+		return l
+	}
 	barkFields := keyValues.Fields()
 
 	// Deterministic ordering of fields.
@@ -61,20 +100,120 @@ func (l barker) WithFields(keyValues bark.LogFields) bark.Logger {
 	}
 
 	l.SugaredLogger = l.SugaredLogger.With(zapFields...) // safe to change because we pass-by-value
+	l.fields = l.withFields(barkFields)
 	return l
 }
 
 func (l barker) WithError(err error) bark.Logger {
 	l.SugaredLogger = l.SugaredLogger.With(zap.Error(err)) // safe to change because we pass-by-value
+	l.fields = l.withFields(bark.Fields{_errorKey: err})
+	return l
+}
+
+// Named adds a sub-scope to the zap logger's name. Since zap records names
+// separately from fields, the name isn't included in Fields.
+func (l barker) Named(name string) bark.Logger {
+	l.SugaredLogger = l.SugaredLogger.Named(name) // safe to change because we pass-by-value
+	return l
+}
+
+func (l barker) AddCallerSkip(skip int) bark.Logger {
+	l.SugaredLogger = l.SugaredLogger.Desugar().WithOptions(zap.AddCallerSkip(skip)).Sugar() // safe to change because we pass-by-value
 	return l
 }
 
 func (l barker) Fields() bark.Fields {
-	// Zap has already marshaled the accumulated logger context to []byte, so we
-	// can't reconstruct the original objects. To satisfy this interface, just
-	// return nil.
-	l.SugaredLogger.Warn("zap-to-bark compatibility wrapper does not support Fields method")
-	return nil
+	return l.fields
+}
+
+// withFields returns a copy of the logger's fields with the provided fields
+// merged in, replacing existing values for the same keys.
+func (l barker) withFields(fields map[string]interface{}) bark.Fields {
+	merged := make(bark.Fields, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return merged
+}
+
+func (l barker) Enabled(level bark.Level) bool {
+	return l.levels.Enabled(toZapLevel(level))
+}
+
+func (l barker) Level() bark.Level {
+	for lvl := bark.DebugLevel; lvl < bark.FatalLevel; lvl++ {
+		if l.Enabled(lvl) {
+			return lvl
+		}
+	}
+	return bark.FatalLevel
+}
+
+func (l barker) Log(level bark.Level, args ...interface{}) {
+	if l.Enabled(level) {
+		l.log(level, fmt.Sprint(args...))
+	}
+}
+
+func (l barker) Logf(level bark.Level, format string, args ...interface{}) {
+	if l.Enabled(level) {
+		l.log(level, fmt.Sprintf(format, args...))
+	}
+}
+
+func (l barker) Fatal(args ...interface{}) {
+	l.log(bark.FatalLevel, fmt.Sprint(args...))
+	l.exit(1)
+}
+
+func (l barker) Fatalf(format string, args ...interface{}) {
+	l.log(bark.FatalLevel, fmt.Sprintf(format, args...))
+	l.exit(1)
+}
+
+// log must be called directly by Fatal, Log or Logf so that the caller is reported
+// correctly.
+func (l barker) log(level bark.Level, msg string) {
+	// Unlike the promoted SugaredLogger methods, these methods are never
+	// wrapped, so _barkifyCallerSkip doesn't apply to them.
+	logger := l.SugaredLogger.Desugar().WithOptions(zap.AddCallerSkip(2 - _barkifyCallerSkip))
+
+	lvl := toZapLevel(level)
+	if lvl > zapcore.ErrorLevel {
+		// zap panics or exits after writing entries checked at these levels,
+		// so check them at error level and promote them in the core.
+		logger = logger.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+			return promotingCore{Core: c, level: lvl}
+		}))
+		lvl = zapcore.ErrorLevel
+	}
+
+	if ce := logger.Check(lvl, msg); ce != nil {
+		ce.Write()
+	}
+}
+
+// promotingCore writes every entry at a fixed level.
+type promotingCore struct {
+	zapcore.Core
+
+	level zapcore.Level
+}
+
+func (c promotingCore) Enabled(zapcore.Level) bool {
+	return c.Core.Enabled(c.level)
+}
+
+func (c promotingCore) With(fields []zapcore.Field) zapcore.Core {
+	return promotingCore{Core: c.Core.With(fields), level: c.level}
+}
+
+func (c promotingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	ent.Level = c.level
+	return c.Core.Check(ent, ce)
 }
 
 // toZapField converts a logrus field to a zap field.
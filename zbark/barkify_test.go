@@ -20,8 +20,12 @@ package zbark_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"path"
+	"runtime"
 	"testing"
 	"time"
 
@@ -29,6 +33,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-common/bark"
+	"github.com/uber-common/bark/barktest"
 	"github.com/uber-common/bark/zbark"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -106,20 +111,202 @@ func TestBarkLoggerWith(t *testing.T) {
 	})
 
 	t.Run("Fields", func(t *testing.T) {
+		err := errors.New("great sadness")
 		l, logs := newTestBarker()
-		l = l.WithField("foo", "bar").WithError(errors.New("great sadness"))
-		assert.Empty(t, l.Fields(), "expected empty field list")
+		assert.Nil(t, l.Fields(), "expected no fields on a fresh logger")
 
-		require.Equal(t, 1, logs.Len(), "message count did not match")
+		l = l.WithField("foo", "bar").WithError(err)
+		assert.Equal(t, bark.Fields{"foo": "bar", "error": err}, l.Fields(), "fields did not match")
+		assert.Equal(t, 0, logs.Len(), "expected Fields not to log")
+	})
+}
 
-		entry := logs.All()[0]
-		assert.Equal(t,
-			"zap-to-bark compatibility wrapper does not support Fields method", entry.Message,
-			"message did not match")
-		assert.Equal(t, zapcore.WarnLevel, entry.Level, "message level did not match")
+func TestBarkLoggerFieldsLogrusParity(t *testing.T) {
+	err := errors.New("great sadness")
+	tests := []struct {
+		desc string
+		with func(bark.Logger) bark.Logger
+	}{
+		{"none", func(l bark.Logger) bark.Logger { return l }},
+		{"nil", func(l bark.Logger) bark.Logger { return l.WithFields(nil) }},
+		{"empty", func(l bark.Logger) bark.Logger { return l.WithFields(bark.Fields{}) }},
+		{"one", func(l bark.Logger) bark.Logger { return l.WithField("foo", "bar") }},
+		{"error", func(l bark.Logger) bark.Logger { return l.WithError(err) }},
+		{"one then one", func(l bark.Logger) bark.Logger {
+			return l.WithField("foo", "bar").WithField("baz", "bump")
+		}},
+		{"two then two", func(l bark.Logger) bark.Logger {
+			return l.WithFields(bark.Fields{"foo": "bar", "baz": "bump"}).WithFields(bark.Fields{"a": "b", "c": "d"})
+		}},
+		{"override", func(l bark.Logger) bark.Logger {
+			return l.WithFields(bark.Fields{"foo": "bar", "baz": "bump"}).WithField("foo", "qux")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			logrusLogger := bark.NewLoggerFromLogrus(logrus.New())
+			zapLogger, _ := newTestBarker()
+			assert.Equal(t, tt.with(logrusLogger).Fields(), tt.with(zapLogger).Fields(),
+				"fields from logrus and zap do not match")
+		})
+	}
+
+	t.Run("immutable", func(t *testing.T) {
+		parent, _ := newTestBarker()
+		parent = parent.WithField("foo", "bar")
+		child := parent.WithField("baz", "bump")
+		parent.WithFields(bark.Fields{"qux": "quux"})
+
+		assert.Equal(t, bark.Fields{"foo": "bar"}, parent.Fields(), "parent fields changed")
+		assert.Equal(t, bark.Fields{"foo": "bar", "baz": "bump"}, child.Fields(), "child fields did not match")
+	})
+}
+
+func TestBarkLoggerConformance(t *testing.T) {
+	barktest.RunLoggerSuite(t, func() bark.Logger {
+		l, _ := newTestBarker()
+		return l
 	})
 }
 
+func TestBarkLoggerFromContext(t *testing.T) {
+	type requestIDKey struct{}
+	defer bark.RegisterContextExtractor(bark.ContextValueExtractor(requestIDKey{}, "request_id"))()
+
+	l, logs := newTestBarker()
+	ctx := context.WithValue(bark.NewContext(context.Background(), l), requestIDKey{}, "abc123")
+	bark.FromContext(ctx).Info("hello")
+
+	require.Equal(t, 1, logs.Len(), "message count did not match")
+	assert.Equal(t, map[string]interface{}{"request_id": "abc123"}, logs.All()[0].ContextMap(),
+		"context did not match")
+}
+
+func TestBarkLoggerLevel(t *testing.T) {
+	core, _ := observer.New(zap.WarnLevel)
+	l := zbark.Barkify(zap.New(core))
+
+	for _, logger := range []bark.Logger{l, l.WithField("foo", "bar")} {
+		levels, ok := logger.(bark.LevelEnabler)
+		require.True(t, ok, "expected Barkify to return a LevelEnabler")
+
+		assert.Equal(t, bark.WarnLevel, levels.Level(), "level did not match")
+		assert.False(t, levels.Enabled(bark.DebugLevel), "debug should be disabled")
+		assert.False(t, levels.Enabled(bark.InfoLevel), "info should be disabled")
+		assert.True(t, levels.Enabled(bark.WarnLevel), "warn should be enabled")
+		assert.True(t, levels.Enabled(bark.FatalLevel), "fatal should be enabled")
+	}
+}
+
+func TestBarkLoggerLevelLogger(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	l := zbark.Barkify(zap.New(core, zap.AddCaller())).WithField("foo", "bar")
+	levels, ok := l.(bark.LevelLogger)
+	require.True(t, ok, "expected Barkify to return a LevelLogger")
+
+	levels.Log(bark.DebugLevel, "disabled")
+	levels.Logf(bark.WarnLevel, "hello %s", "world")
+	assert.NotPanics(t, func() { levels.Log(bark.PanicLevel, "oh ", "no") }, "Log should never panic")
+	levels.Logf(bark.FatalLevel, "oh %s", "no")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 3, "message count did not match")
+	assert.Equal(t, zapcore.WarnLevel, entries[0].Level)
+	assert.Equal(t, "hello world", entries[0].Message)
+	assert.Equal(t, zapcore.PanicLevel, entries[1].Level)
+	assert.Equal(t, zapcore.FatalLevel, entries[2].Level)
+	for _, e := range entries {
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, e.ContextMap(), "context did not match")
+		assert.Equal(t, "barkify_test.go", path.Base(e.Caller.File), "incorrect caller file")
+	}
+
+	fatalCore, fatalLogs := observer.New(zap.FatalLevel)
+	fatalOnly := zbark.Barkify(zap.New(fatalCore)).(bark.LevelLogger)
+	fatalOnly.Log(bark.ErrorLevel, "disabled")
+	fatalOnly.Log(bark.FatalLevel, "enabled")
+	assert.Equal(t, 1, fatalLogs.FilterMessage("enabled").Len(), "expected fatal entries on a fatal-only core")
+	assert.Equal(t, 1, fatalLogs.Len(), "message count did not match")
+}
+
+func TestBarkLoggerFatal(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+
+	var events []string
+	l := zbark.Barkify(zap.New(core, zap.AddCaller()),
+		bark.WithPreExitHook(func() {
+			assert.Equal(t, len(events)/2+1, logs.Len(), "expected hooks to run after the entry is written")
+			events = append(events, "hook")
+		}),
+		bark.WithExitFunc(func(code int) { events = append(events, fmt.Sprint("exit ", code)) }),
+	)
+
+	l.Fatal("oh ", "no")
+	l.WithField("foo", "bar").Fatalf("oh %s", "no")
+	assert.Equal(t, []string{"hook", "exit 1", "hook", "exit 1"}, events, "exit events did not match")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2, "message count did not match")
+	for _, e := range entries {
+		assert.Equal(t, zapcore.FatalLevel, e.Level, "level did not match")
+		assert.Equal(t, "oh no", e.Message, "message did not match")
+		assert.Equal(t, "barkify_test.go", path.Base(e.Caller.File), "incorrect caller file")
+	}
+	assert.Equal(t, map[string]interface{}{"foo": "bar"}, entries[1].ContextMap(), "context did not match")
+}
+
+func TestBarkLoggerCallerSkip(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	l := zbark.Barkify(zap.New(core, zap.AddCaller()))
+	skipper, ok := l.(bark.CallerSkipper)
+	require.True(t, ok, "expected Barkify to return a CallerSkipper")
+
+	logVia := func(l bark.Logger) { l.WithField("foo", "bar").Info("hello") }
+	_, _, line, _ := runtime.Caller(0)
+	logVia(skipper.AddCallerSkip(1))
+	logVia(l)
+	skipper.AddCallerSkip(1).(bark.LevelLogger).Log(bark.ErrorLevel, "hello")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 3, "message count did not match")
+	assert.Equal(t, line+1, entries[0].Caller.Line, "expected the helper's frame to be skipped")
+	assert.Equal(t, line-1, entries[1].Caller.Line, "expected skips not to affect the parent logger")
+	assert.Equal(t, "testing.go", path.Base(entries[2].Caller.File), "expected Log to skip the test's frame")
+}
+
+func TestBarkLoggerNamed(t *testing.T) {
+	l, logs := newTestBarker()
+	named, ok := l.(bark.NamedLogger)
+	require.True(t, ok, "expected Barkify to return a NamedLogger")
+
+	child := named.Named("storage").WithField("foo", "bar").(bark.NamedLogger).Named("cache")
+	child.Info("hello")
+	assert.Equal(t, bark.Fields{"foo": "bar"}, child.Fields(), "names should not be included in fields")
+
+	require.Equal(t, 1, logs.Len(), "message count did not match")
+	assert.Equal(t, "storage.cache", logs.All()[0].LoggerName, "logger name did not match")
+}
+
+func TestBarkLoggerLevelRegistry(t *testing.T) {
+	l, logs := newTestBarker()
+	r := bark.NewLevelRegistry(bark.InfoLevel)
+	r.SetLevel("storage", bark.DebugLevel)
+
+	root := bark.NewNamedLogger(l, r)
+	root.Debug("dropped")
+	root.(bark.NamedLogger).Named("storage").(bark.NamedLogger).Named("cache").Debug("kept")
+
+	require.Equal(t, 1, logs.Len(), "message count did not match")
+	assert.Equal(t, "storage.cache", logs.All()[0].LoggerName, "expected names to map to zap's names")
+}
+
+func TestBarkLoggerSync(t *testing.T) {
+	l, _ := newTestBarker()
+	syncer, ok := l.WithField("foo", "bar").(bark.Syncer)
+	require.True(t, ok, "expected Barkify to return a Syncer")
+	assert.NoError(t, syncer.Sync(), "unexpected error syncing")
+}
+
 func TestZapLogrusParity(t *testing.T) {
 	var (
 		boolv     bool          = true
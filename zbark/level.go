@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package zbark
+
+import (
+	"github.com/uber-common/bark"
+	"go.uber.org/zap/zapcore"
+)
+
+// toZapLevel converts a bark level to the equivalent zap level.
+func toZapLevel(lvl bark.Level) zapcore.Level {
+	switch lvl {
+	case bark.DebugLevel:
+		return zapcore.DebugLevel
+	case bark.InfoLevel:
+		return zapcore.InfoLevel
+	case bark.WarnLevel:
+		return zapcore.WarnLevel
+	case bark.ErrorLevel:
+		return zapcore.ErrorLevel
+	case bark.PanicLevel:
+		return zapcore.PanicLevel
+	case bark.FatalLevel:
+		return zapcore.FatalLevel
+	}
+
+	if lvl < bark.DebugLevel {
+		return zapcore.DebugLevel
+	}
+	return zapcore.FatalLevel
+}
+
+// toBarkLevel converts a zap level to the bark level it is logged at. Like
+// Zapify, this treats zap's DPanicLevel as an error.
+func toBarkLevel(lvl zapcore.Level) bark.Level {
+	switch lvl {
+	case zapcore.DebugLevel:
+		return bark.DebugLevel
+	case zapcore.InfoLevel:
+		return bark.InfoLevel
+	case zapcore.WarnLevel:
+		return bark.WarnLevel
+	case zapcore.ErrorLevel, zapcore.DPanicLevel:
+		return bark.ErrorLevel
+	case zapcore.PanicLevel:
+		return bark.PanicLevel
+	case zapcore.FatalLevel:
+		return bark.FatalLevel
+	}
+
+	if lvl < zapcore.DebugLevel {
+		return bark.DebugLevel
+	}
+	return bark.FatalLevel
+}
@@ -42,9 +42,12 @@ type zapper struct {
 
 func (z *zapper) Enabled(lvl zapcore.Level) bool {
 	// Enabled allows zap to short-circuit some logging calls early, which
-	// improves performance. Since we're not sure what levels are enabled on the
-	// underlying bark logger, always return true; this hurts performance but
-	// not correctness.
+	// improves performance. If the underlying bark logger can't tell us which
+	// levels it writes, always return true; this hurts performance but not
+	// correctness.
+	if levels, ok := z.l.(bark.LevelEnabler); ok {
+		return levels.Enabled(toBarkLevel(lvl))
+	}
 	return true
 }
 
@@ -53,8 +56,10 @@ func (z *zapper) With(fs []zapcore.Field) zapcore.Core {
 }
 
 func (z *zapper) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
-	// Since Enabled is always true, there's no need to check it here.
-	return ce.AddCore(ent, z)
+	if z.Enabled(ent.Level) {
+		return ce.AddCore(ent, z)
+	}
+	return ce
 }
 
 func (z *zapper) Write(ent zapcore.Entry, fs []zapcore.Field) error {
@@ -84,7 +89,10 @@ func (z *zapper) Write(ent zapcore.Entry, fs []zapcore.Field) error {
 }
 
 func (z *zapper) Sync() error {
-	// Bark doesn't expose a way to flush buffered messages.
+	// Bark loggers may optionally expose a way to flush buffered messages.
+	if s, ok := z.l.(bark.Syncer); ok {
+		return s.Sync()
+	}
 	return nil
 }
 
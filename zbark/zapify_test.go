@@ -21,6 +21,8 @@ package zbark_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"os"
 	"testing"
 
 	"go.uber.org/zap"
@@ -33,6 +35,10 @@ import (
 )
 
 func newTestZapper() (*zap.Logger, *bytes.Buffer) {
+	return newTestZapperAt(logrus.DebugLevel)
+}
+
+func newTestZapperAt(lvl logrus.Level) (*zap.Logger, *bytes.Buffer) {
 	buf := bytes.NewBuffer(nil)
 	barkLogger := &logrus.Logger{
 		Out:   buf,
@@ -40,11 +46,28 @@ func newTestZapper() (*zap.Logger, *bytes.Buffer) {
 		Formatter: &logrus.JSONFormatter{
 			DisableTimestamp: true,
 		},
-		Level: logrus.DebugLevel,
+		Level: lvl,
 	}
 	return zbark.Zapify(bark.NewLoggerFromLogrus(barkLogger)), buf
 }
 
+// opaqueLogger hides every method of the wrapped logger that isn't part of
+// the bark.Logger interface.
+type opaqueLogger struct{ bark.Logger }
+
+// syncLogger records calls to Sync.
+type syncLogger struct {
+	bark.Logger
+
+	syncs int
+	err   error
+}
+
+func (l *syncLogger) Sync() error {
+	l.syncs++
+	return l.err
+}
+
 func assertJSON(t testing.TB, expected map[string]interface{}, buf *bytes.Buffer) {
 	line := bytes.TrimSpace(buf.Bytes())
 	msg := make(map[string]interface{})
@@ -102,3 +125,56 @@ func TestZapLoggerLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestZapLoggerLevels(t *testing.T) {
+	log, buf := newTestZapperAt(logrus.WarnLevel)
+
+	assert.False(t, log.Core().Enabled(zapcore.InfoLevel), "info should be disabled")
+	assert.True(t, log.Core().Enabled(zapcore.WarnLevel), "warn should be enabled")
+	assert.True(t, log.Core().Enabled(zapcore.DPanicLevel), "dpanic should be enabled")
+
+	assert.Nil(t, log.Check(zapcore.InfoLevel, "hello"), "expected disabled entry to be skipped")
+	log.With(zap.String("foo", "bar")).Info("hello")
+	assert.Zero(t, buf.Len(), "expected no output for disabled level")
+
+	if ce := log.Check(zapcore.WarnLevel, "hello"); assert.NotNil(t, ce, "expected enabled entry") {
+		ce.Write()
+	}
+	assertJSON(t, map[string]interface{}{
+		"msg":   "hello",
+		"level": "warning",
+	}, buf)
+}
+
+func TestZapLoggerLevelsUnknown(t *testing.T) {
+	log := zbark.Zapify(opaqueLogger{bark.NewNopLogger()})
+	for lvl := zapcore.DebugLevel; lvl <= zapcore.FatalLevel; lvl++ {
+		assert.True(t, log.Core().Enabled(lvl), "levels should all be enabled without a bark.LevelEnabler")
+	}
+}
+
+func TestZapLoggerSync(t *testing.T) {
+	t.Run("Syncer", func(t *testing.T) {
+		l := &syncLogger{Logger: bark.NewNopLogger()}
+		log := zbark.Zapify(l)
+		assert.NoError(t, log.Sync(), "unexpected error syncing")
+		assert.Equal(t, 1, l.syncs, "expected bark logger to be synced")
+
+		l.err = errors.New("great sadness")
+		assert.Equal(t, l.err, log.Sync(), "expected sync error to be returned")
+	})
+
+	t.Run("not a Syncer", func(t *testing.T) {
+		log := zbark.Zapify(opaqueLogger{bark.NewNopLogger()})
+		assert.NoError(t, log.Sync(), "unexpected error syncing")
+	})
+
+	t.Run("default logrus output", func(t *testing.T) {
+		for _, out := range []*os.File{os.Stderr, os.Stdout} {
+			l := logrus.New()
+			l.Out = out
+			log := zbark.Zapify(bark.NewLoggerFromLogrus(l))
+			assert.NoError(t, log.Sync(), "expected %v not to be synced", out.Name())
+		}
+	})
+}